@@ -0,0 +1,121 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultAppRoleTokenTTL bounds a JWT minted from an AppRole login when
+// neither the request nor the AppRole itself specifies a TokenTTL.
+const DefaultAppRoleTokenTTL = time.Hour
+
+// appRoleLoginHandler handles POST /api/v1/auth/approle/login. Unlike
+// /api/v1/auth/login, which proxies to core, this authenticates entirely
+// within the control plane: LoginWithAppRoleUseCase verifies the
+// (role_id, secret_id) pair and this handler then mints the JWT, since
+// minting requires jwtSigningSecret, which the domain/application layers
+// never touch. The minted token is always HS256-signed with
+// jwtSigningSecret, even when cp.authClient itself verifies against a
+// JWKS provider, because minting needs a private key this service doesn't
+// hold in JWKS mode.
+func (cp *ControlPlaneV1) appRoleLoginHandler(c *gin.Context) {
+	var req dto.AppRoleLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "message": err.Error()})
+		return
+	}
+
+	sourceIP := net.ParseIP(c.ClientIP())
+
+	result, err := cp.loginWithAppRoleUC.Execute(req, sourceIP)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized", "message": err.Error()})
+		return
+	}
+
+	tokenTTL := result.TokenTTL
+	if tokenTTL <= 0 {
+		tokenTTL = DefaultAppRoleTokenTTL
+	}
+
+	claims := &Claims{
+		UserID:   result.RoleID,
+		Username: result.RoleID,
+		TenantID: result.TenantID,
+		Role:     entities.RoleServiceAccount,
+		IsAPIKey: true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(cp.jwtSigningSecret)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to mint token"})
+		return
+	}
+
+	cp.auditLogger.LogAuthEvent("approle_login", result.RoleID, result.RoleID, result.TenantID, "logged in via AppRole")
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token":   signed,
+		"token_type":     "Bearer",
+		"expires_in":     int(tokenTTL.Seconds()),
+		"bound_policies": result.BoundPolicies,
+	})
+}
+
+// createAppRoleHandler handles POST /api/v1/approles (admin only).
+func (cp *ControlPlaneV1) createAppRoleHandler(c *gin.Context) {
+	var req dto.CreateAppRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "message": err.Error()})
+		return
+	}
+
+	resp, err := cp.createAppRoleUC.Execute(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// rotateAppRoleIDHandler handles POST /api/v1/approles/:id/rotate (admin
+// only).
+func (cp *ControlPlaneV1) rotateAppRoleIDHandler(c *gin.Context) {
+	resp, err := cp.rotateRoleIDUC.Execute(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// generateSecretIDHandler handles POST /api/v1/approles/:id/secret-id
+// (admin only).
+func (cp *ControlPlaneV1) generateSecretIDHandler(c *gin.Context) {
+	var req dto.GenerateSecretIDRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "message": err.Error()})
+		return
+	}
+
+	resp, err := cp.generateSecretIDUC.Execute(c.Param("id"), req)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
@@ -1,89 +1,605 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // AuditEvent represents an auditable action
 type AuditEvent struct {
-	Timestamp   time.Time          `json:"timestamp"`
-	EventType   string             `json:"event_type"`
-	UserID      string             `json:"user_id,omitempty"`
-	Username    string             `json:"username,omitempty"`
-	TenantID    string             `json:"tenant_id,omitempty"`
-	Action      string             `json:"action"`
-	Resource    string             `json:"resource,omitempty"`
-	ResourceID  string             `json:"resource_id,omitempty"`
-	Method      string             `json:"method"`
-	Path        string             `json:"path"`
-	StatusCode  int                `json:"status_code"`
-	Duration    float64            `json:"duration_ms"`
-	IPAddress   string             `json:"ip_address,omitempty"`
-	UserAgent   string             `json:"user_agent,omitempty"`
-	Error       string             `json:"error,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-}
-
-// AuditLogger handles audit logging
-type AuditLogger struct {
-	file   *os.File
-	mu     sync.Mutex
-	enabled bool
+	Timestamp  time.Time              `json:"timestamp"`
+	EventType  string                 `json:"event_type"`
+	UserID     string                 `json:"user_id,omitempty"`
+	Username   string                 `json:"username,omitempty"`
+	TenantID   string                 `json:"tenant_id,omitempty"`
+	Action     string                 `json:"action"`
+	Resource   string                 `json:"resource,omitempty"`
+	ResourceID string                 `json:"resource_id,omitempty"`
+	Method     string                 `json:"method"`
+	Path       string                 `json:"path"`
+	StatusCode int                    `json:"status_code"`
+	Duration   float64                `json:"duration_ms"`
+	IPAddress  string                 `json:"ip_address,omitempty"`
+	UserAgent  string                 `json:"user_agent,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+
+	// RouteTemplate is the matched route pattern (e.g. "/tenants/:id"),
+	// distinct from Path which carries the literal request path.
+	RouteTemplate string `json:"route_template,omitempty"`
+	// CorrelationID ties this event to a distributed trace: the trace-id
+	// portion of an incoming W3C traceparent header, or a generated UUID
+	// when the request carried none.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// RequestBody and ResponseBody are populated only for resources opted
+	// into capture by AuditLogger's ContextConfig, redacted and truncated
+	// per its rules.
+	RequestBody  string `json:"request_body,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+	// DownstreamCalls records outbound RPCs the handler made while serving
+	// this request (see RecordDownstreamCall), e.g. "GET core:/api/v1/stats".
+	DownstreamCalls []string `json:"downstream_calls,omitempty"`
+
+	// PrevHash is the Hash of the previous record in the log, forming a
+	// hash chain. The first record in a log has an empty PrevHash.
+	PrevHash string `json:"prev_hash,omitempty"`
+	// Hash is the SHA-256, hex-encoded, of this record's canonical JSON
+	// (with Hash and Signature cleared) chained onto PrevHash.
+	Hash string `json:"hash,omitempty"`
+	// Signature is the hex-encoded Ed25519 signature of the raw Hash
+	// bytes, present only when the logger was created with a signing key.
+	Signature string `json:"signature,omitempty"`
 }
 
-// NewAuditLogger creates a new audit logger
-func NewAuditLogger(filePath string) (*AuditLogger, error) {
-	if filePath == "" {
-		// Audit logging disabled
-		return &AuditLogger{enabled: false}, nil
-	}
+// DefaultCheckpointInterval is how many records a signing FileAuditSink
+// writes between "audit.checkpoint" records that re-sign the chain head.
+const DefaultCheckpointInterval = 100
+
+// AuditSink is a single destination for audit events: a file, syslog,
+// Kafka, an OTLP collector, an S3 bucket. Implementations must be safe for
+// concurrent use; AuditLogger fans an event out to every configured sink.
+type AuditSink interface {
+	Log(event AuditEvent) error
+	Close() error
+}
 
+// FileAuditSink is the original audit sink: a local, hash-chained, and
+// optionally Ed25519-signed JSONL file (see VerifyAuditLog).
+type FileAuditSink struct {
+	file *os.File
+	mu   sync.Mutex
+
+	lastHash        string
+	signingKey      ed25519.PrivateKey
+	checkpointEvery int
+	eventCount      int
+}
+
+// NewFileAuditSink opens filePath for append and returns a sink that
+// hash-chains every record onto the one before it. If signingKey is
+// non-nil, records are also Ed25519-signed and a checkpoint record is
+// emitted every checkpointEvery records (DefaultCheckpointInterval if
+// zero).
+func NewFileAuditSink(filePath string, signingKey ed25519.PrivateKey, checkpointEvery int) (*FileAuditSink, error) {
 	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open audit log file: %w", err)
 	}
 
-	return &AuditLogger{
-		file:   file,
-		enabled: true,
+	if signingKey != nil && checkpointEvery <= 0 {
+		checkpointEvery = DefaultCheckpointInterval
+	}
+
+	return &FileAuditSink{
+		file:            file,
+		signingKey:      signingKey,
+		checkpointEvery: checkpointEvery,
 	}, nil
 }
 
-// Log writes an audit event to the log file
-func (a *AuditLogger) Log(event AuditEvent) error {
-	if !a.enabled {
-		return nil
-	}
-
+// Log writes an audit event to the log file, chaining it onto the
+// previous record's hash and signing it if the sink was created with a
+// signing key. It may also emit a trailing checkpoint record.
+func (a *FileAuditSink) Log(event AuditEvent) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	event.Timestamp = time.Now().UTC()
+	if err := a.appendLocked(event); err != nil {
+		return err
+	}
 
-	data, err := json.Marshal(event)
+	a.eventCount++
+	if a.signingKey != nil && a.checkpointEvery > 0 && a.eventCount%a.checkpointEvery == 0 {
+		checkpoint := AuditEvent{
+			EventType: "audit.checkpoint",
+			Action:    "checkpoint",
+			Metadata: map[string]interface{}{
+				"chain_head": a.lastHash,
+			},
+		}
+		if err := a.appendLocked(checkpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendLocked finalizes event (timestamp, hash chain, optional
+// signature) and writes it to the log file. Callers must hold a.mu.
+func (a *FileAuditSink) appendLocked(event AuditEvent) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+	event.PrevHash = a.lastHash
+	event.Hash = ""
+	event.Signature = ""
+
+	canonical, err := json.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to marshal audit event: %w", err)
 	}
+	sum := sha256.Sum256(canonical)
+	event.Hash = hex.EncodeToString(sum[:])
+
+	if a.signingKey != nil {
+		event.Signature = hex.EncodeToString(ed25519.Sign(a.signingKey, sum[:]))
+	}
 
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
 	if _, err := a.file.Write(append(data, '\n')); err != nil {
 		return fmt.Errorf("failed to write audit event: %w", err)
 	}
 
+	a.lastHash = event.Hash
 	return nil
 }
 
-// Close closes the audit log file
+// Close closes the underlying audit log file.
+func (a *FileAuditSink) Close() error {
+	return a.file.Close()
+}
+
+// VerifyAuditLog walks the hash-chained audit log at path from the
+// start of the file, recomputing each record's hash (and, if pubKey is
+// non-nil, validating its signature). It returns every successfully
+// verified event along with an error identifying the line number of the
+// first tampered or broken record, if any.
+func VerifyAuditLog(path string, pubKey ed25519.PublicKey) ([]AuditEvent, error) {
+	return VerifyChain(path, "", 0, pubKey)
+}
+
+// VerifyChain walks the hash-chained audit log at path, the same way
+// VerifyAuditLog does, except it can resume from a known-good point in
+// the chain instead of always rescanning from the beginning:
+//
+//   - fromHash, if non-empty, is a previously-verified record's Hash
+//     (e.g. a checkpoint's chain_head, or the last event a prior
+//     VerifyChain call returned). Records up to and including it are
+//     skipped rather than re-verified; an error is returned if fromHash
+//     never appears in the file.
+//   - limit, if positive, stops after verifying that many records.
+//
+// It returns every newly verified event, and an error identifying the
+// line number of the first tampered or broken record, if any.
+func VerifyChain(path string, fromHash string, limit int, pubKey ed25519.PublicKey) ([]AuditEvent, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	defer file.Close()
+
+	var events []AuditEvent
+	prevHash := ""
+	seeking := fromHash != ""
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		var event AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return events, fmt.Errorf("audit log tampered at line %d: invalid JSON: %w", line, err)
+		}
+
+		if seeking {
+			if event.Hash != fromHash {
+				continue
+			}
+			seeking = false
+			prevHash = event.Hash
+			continue
+		}
+
+		claimedHash, err := verifyChainRecord(event, prevHash, pubKey)
+		if err != nil {
+			return events, fmt.Errorf("audit log tampered at line %d: %w", line, err)
+		}
+
+		events = append(events, event)
+		prevHash = claimedHash
+
+		if limit > 0 && len(events) >= limit {
+			return events, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil && err != io.EOF {
+		return events, fmt.Errorf("failed to read audit log file: %w", err)
+	}
+
+	if seeking {
+		return nil, fmt.Errorf("from_hash %q not found in audit log", fromHash)
+	}
+
+	return events, nil
+}
+
+// verifyChainRecord checks that event chains onto prevHash and that its
+// claimed Hash (and, if pubKey is non-nil and event carries a
+// Signature, that signature) is correct, returning event.Hash for the
+// caller to chain the next record onto.
+func verifyChainRecord(event AuditEvent, prevHash string, pubKey ed25519.PublicKey) (string, error) {
+	if event.PrevHash != prevHash {
+		return "", errors.New("prev_hash mismatch")
+	}
+
+	claimedHash := event.Hash
+	claimedSignature := event.Signature
+	recomputable := event
+	recomputable.Hash = ""
+	recomputable.Signature = ""
+
+	canonical, err := json.Marshal(recomputable)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	expectedHash := hex.EncodeToString(sum[:])
+
+	if claimedHash != expectedHash {
+		return "", errors.New("hash mismatch")
+	}
+
+	if pubKey != nil && claimedSignature != "" {
+		sigBytes, err := hex.DecodeString(claimedSignature)
+		if err != nil || !ed25519.Verify(pubKey, sum[:], sigBytes) {
+			return "", errors.New("signature invalid")
+		}
+	}
+
+	return claimedHash, nil
+}
+
+// DefaultMaxBodyBytes caps how much of a captured request or response body
+// ContextConfig retains per event, when MaxBodyBytes is unset.
+const DefaultMaxBodyBytes = 8 * 1024
+
+// ContextConfig governs AuditMiddleware's optional capture of request and
+// response bodies: which resources (as returned by extractResource) opt in,
+// how much of a body is retained, which JSON fields get redacted before a
+// body is ever logged, and a deny-list that overrides everything else. It
+// exists to give sensitive endpoints (tenant creation, backup restore)
+// SIEM-quality context without paying that cost on hot read paths.
+type ContextConfig struct {
+	// CaptureResources lists the extractResource values whose requests get
+	// bodies attached, e.g. {"tenant", "backup"}.
+	CaptureResources []string
+	// MaxBodyBytes caps retained body size; DefaultMaxBodyBytes if zero.
+	MaxBodyBytes int
+	// RedactFields lists JSON field names, matched case-insensitively at
+	// any depth, whose values are replaced with "[REDACTED]" before a body
+	// is attached to an event.
+	RedactFields []string
+	// DenyPaths lists exact request paths that must never have bodies
+	// captured, regardless of CaptureResources.
+	DenyPaths []string
+}
+
+func (c ContextConfig) maxBodyBytes() int {
+	if c.MaxBodyBytes <= 0 {
+		return DefaultMaxBodyBytes
+	}
+	return c.MaxBodyBytes
+}
+
+// AuditConfig selects and configures the sinks a fan-out AuditLogger
+// writes to. Every non-nil sink is wrapped in a bounded async queue
+// (QueueSize, DefaultAuditQueueSize if zero) so a slow sink can't block
+// the Gin request path.
+type AuditConfig struct {
+	// FilePath, if set, enables the local hash-chained FileAuditSink.
+	FilePath        string
+	SigningKey      ed25519.PrivateKey
+	CheckpointEvery int
+
+	Syslog   *SyslogSinkConfig
+	Kafka    *KafkaSinkConfig
+	OTLP     *OTLPSinkConfig
+	S3       *S3SinkConfig
+	Postgres *PostgresSinkConfig
+
+	QueueSize int
+
+	// Context enables request/response body capture for AuditMiddleware.
+	// Left nil, no bodies are ever captured.
+	Context *ContextConfig
+}
+
+// AuditLogger fans audit events out to every sink selected by an
+// AuditConfig. With no sinks configured it is a safe no-op, matching the
+// historical "audit logging disabled" behavior.
+type AuditLogger struct {
+	sinks []AuditSink
+
+	contextConfig ContextConfig
+	captureSet    map[string]bool
+	redactFields  map[string]bool
+
+	// policyHits backs GET /policies/coverage. It records independently of
+	// sinks, so coverage still works with audit logging itself disabled.
+	policyHits *policyHitRing
+}
+
+// NewAuditLogger builds the sinks selected by cfg and wraps each in a
+// bounded async queue.
+func NewAuditLogger(cfg AuditConfig) (*AuditLogger, error) {
+	var sinks []AuditSink
+
+	if cfg.FilePath != "" {
+		sink, err := NewFileAuditSink(cfg.FilePath, cfg.SigningKey, cfg.CheckpointEvery)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize file audit sink: %w", err)
+		}
+		sinks = append(sinks, newAsyncSink("file", sink, cfg.QueueSize))
+	}
+
+	if cfg.Syslog != nil {
+		sink, err := NewSyslogAuditSink(*cfg.Syslog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize syslog audit sink: %w", err)
+		}
+		sinks = append(sinks, newAsyncSink("syslog", sink, cfg.QueueSize))
+	}
+
+	if cfg.Kafka != nil {
+		sink, err := NewKafkaAuditSink(*cfg.Kafka)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize kafka audit sink: %w", err)
+		}
+		sinks = append(sinks, newAsyncSink("kafka", sink, cfg.QueueSize))
+	}
+
+	if cfg.OTLP != nil {
+		sink, err := NewOTLPAuditSink(*cfg.OTLP)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize OTLP audit sink: %w", err)
+		}
+		sinks = append(sinks, newAsyncSink("otlp", sink, cfg.QueueSize))
+	}
+
+	if cfg.S3 != nil {
+		sink, err := NewS3AuditSink(*cfg.S3)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize s3 audit sink: %w", err)
+		}
+		sinks = append(sinks, newAsyncSink("s3", sink, cfg.QueueSize))
+	}
+
+	if cfg.Postgres != nil {
+		sink, err := NewPostgresAuditSink(context.Background(), *cfg.Postgres)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize postgres audit sink: %w", err)
+		}
+		sinks = append(sinks, newAsyncSink("postgres", sink, cfg.QueueSize))
+	}
+
+	logger := &AuditLogger{sinks: sinks, policyHits: newPolicyHitRing()}
+
+	if cfg.Context != nil {
+		logger.contextConfig = *cfg.Context
+
+		logger.captureSet = make(map[string]bool, len(cfg.Context.CaptureResources))
+		for _, resource := range cfg.Context.CaptureResources {
+			logger.captureSet[resource] = true
+		}
+
+		logger.redactFields = make(map[string]bool, len(cfg.Context.RedactFields))
+		for _, field := range cfg.Context.RedactFields {
+			logger.redactFields[strings.ToLower(field)] = true
+		}
+	}
+
+	return logger, nil
+}
+
+// shouldCaptureBody reports whether requests to path, classified as
+// resource, should have their bodies captured under a.contextConfig.
+func (a *AuditLogger) shouldCaptureBody(resource, path string) bool {
+	for _, denied := range a.contextConfig.DenyPaths {
+		if denied == path {
+			return false
+		}
+	}
+	return a.captureSet[resource]
+}
+
+// redactBody walks a JSON body and replaces the value of any object key
+// matching a.redactFields (case-insensitive, any depth) with "[REDACTED]".
+// Bodies that aren't valid JSON (including ones truncated mid-token by the
+// capture size cap) are returned unredacted, since redaction can only
+// reason about well-formed structure.
+func (a *AuditLogger) redactBody(body string) string {
+	if body == "" || len(a.redactFields) == 0 {
+		return body
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(body), &parsed); err != nil {
+		return body
+	}
+
+	redactValue(parsed, a.redactFields)
+
+	redacted, err := json.Marshal(parsed)
+	if err != nil {
+		return body
+	}
+	return string(redacted)
+}
+
+// redactValue recursively replaces values of fields whose key is in fields
+// with "[REDACTED]", descending into nested objects and arrays.
+func redactValue(v interface{}, fields map[string]bool) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, nested := range val {
+			if fields[strings.ToLower(key)] {
+				val[key] = "[REDACTED]"
+				continue
+			}
+			redactValue(nested, fields)
+		}
+	case []interface{}:
+		for _, item := range val {
+			redactValue(item, fields)
+		}
+	}
+}
+
+// Enabled reports whether at least one sink is configured.
+func (a *AuditLogger) Enabled() bool {
+	return len(a.sinks) > 0
+}
+
+// Log fans event out to every configured sink. Errors from individual
+// sinks are joined and returned, but one sink failing never prevents the
+// others from receiving the event.
+func (a *AuditLogger) Log(event AuditEvent) error {
+	if len(a.sinks) == 0 {
+		return nil
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	var errs []error
+	for _, sink := range a.sinks {
+		if err := sink.Log(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// recordPolicyHit records a policy evaluation outcome for GET
+// /policies/coverage. It's independent of Log and a.sinks, since coverage
+// should reflect traffic even when no audit sink is configured.
+func (a *AuditLogger) recordPolicyHit(policyID string, action PolicyAction) {
+	if policyID == "" {
+		return
+	}
+	a.policyHits.record(PolicyHitRecord{PolicyID: policyID, Action: action})
+}
+
+// RecentPolicyHits returns the last n policy evaluation outcomes recorded
+// via recordPolicyHit (n <= 0 for every one still retained).
+func (a *AuditLogger) RecentPolicyHits(n int) []PolicyHitRecord {
+	return a.policyHits.snapshot(n)
+}
+
+// Close closes every configured sink, draining its async queue first.
 func (a *AuditLogger) Close() error {
-	if a.file != nil {
-		return a.file.Close()
+	var errs []error
+	for _, sink := range a.sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	return nil
+	return errors.Join(errs...)
+}
+
+// auditResponseWriter wraps gin.ResponseWriter to tee written bytes into an
+// in-memory buffer, capped at maxBytes, so AuditMiddleware can attach a
+// response body to an event without holding up or altering what the client
+// receives.
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	buf      bytes.Buffer
+	maxBytes int
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	if room := w.maxBytes - w.buf.Len(); room > 0 {
+		if room > len(data) {
+			room = len(data)
+		}
+		w.buf.Write(data[:room])
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// correlationIDFor returns the trace-id segment of an incoming W3C
+// traceparent header ("version-traceid-spanid-flags"), or a generated UUID
+// if the request carried none, so every audit event can be tied back to a
+// distributed trace.
+func correlationIDFor(c *gin.Context) string {
+	if traceparent := c.GetHeader("traceparent"); traceparent != "" {
+		parts := strings.Split(traceparent, "-")
+		if len(parts) == 4 && len(parts[1]) == 32 {
+			return parts[1]
+		}
+	}
+	return uuid.NewString()
+}
+
+// downstreamCallsContextKey is the Gin context key RecordDownstreamCall
+// appends to; AuditMiddleware folds the accumulated list into
+// AuditEvent.DownstreamCalls.
+const downstreamCallsContextKey = "audit_downstream_calls"
+
+// RecordDownstreamCall appends a short description of an outbound RPC (e.g.
+// "GET core:/api/v1/stats") to the current request's audit trail. Handlers
+// that call out to the core service or another dependency should call this
+// alongside the request so AuditMiddleware can report it.
+func RecordDownstreamCall(c *gin.Context, description string) {
+	existing, _ := c.Get(downstreamCallsContextKey)
+	calls, _ := existing.([]string)
+	c.Set(downstreamCallsContextKey, append(calls, description))
+}
+
+// downstreamCallsFromContext retrieves the calls RecordDownstreamCall
+// accumulated for this request, if any.
+func downstreamCallsFromContext(c *gin.Context) []string {
+	value, exists := c.Get(downstreamCallsContextKey)
+	if !exists {
+		return nil
+	}
+	calls, _ := value.([]string)
+	return calls
 }
 
 // AuditMiddleware logs all requests for audit purposes
@@ -96,6 +612,19 @@ func AuditMiddleware(logger *AuditLogger) gin.HandlerFunc {
 		}
 
 		start := time.Now()
+		correlationID := correlationIDFor(c)
+		c.Writer.Header().Set("X-Correlation-ID", correlationID)
+
+		resource := extractResource(c.Request.URL.Path)
+		captureBody := logger.shouldCaptureBody(resource, c.Request.URL.Path)
+
+		var requestBody string
+		var respWriter *auditResponseWriter
+		if captureBody {
+			requestBody = captureRequestBody(c, logger.contextConfig.maxBodyBytes())
+			respWriter = &auditResponseWriter{ResponseWriter: c.Writer, maxBytes: logger.contextConfig.maxBodyBytes()}
+			c.Writer = respWriter
+		}
 
 		// Process request
 		c.Next()
@@ -118,18 +647,28 @@ func AuditMiddleware(logger *AuditLogger) gin.HandlerFunc {
 
 		// Create audit event
 		event := AuditEvent{
-			EventType:  "api_request",
-			UserID:     userID,
-			Username:   username,
-			TenantID:   tenantID,
-			Action:     action,
-			Resource:   extractResource(c.Request.URL.Path),
-			Method:     c.Request.Method,
-			Path:       c.Request.URL.Path,
-			StatusCode: c.Writer.Status(),
-			Duration:   float64(duration),
-			IPAddress:  c.ClientIP(),
-			UserAgent:  c.Request.UserAgent(),
+			EventType:       "api_request",
+			UserID:          userID,
+			Username:        username,
+			TenantID:        tenantID,
+			Action:          action,
+			Resource:        resource,
+			Method:          c.Request.Method,
+			Path:            c.Request.URL.Path,
+			RouteTemplate:   c.FullPath(),
+			StatusCode:      c.Writer.Status(),
+			Duration:        float64(duration),
+			IPAddress:       c.ClientIP(),
+			UserAgent:       c.Request.UserAgent(),
+			CorrelationID:   correlationID,
+			DownstreamCalls: downstreamCallsFromContext(c),
+		}
+
+		if captureBody {
+			event.RequestBody = logger.redactBody(requestBody)
+			if respWriter != nil {
+				event.ResponseBody = logger.redactBody(respWriter.buf.String())
+			}
 		}
 
 		// Add error if request failed
@@ -139,14 +678,66 @@ func AuditMiddleware(logger *AuditLogger) gin.HandlerFunc {
 			}
 		}
 
+		// If PolicyEnforcementMiddleware ran, record which policy decided
+		// this request so every audit record answers "which rule did this?"
+		if decision, ok := decisionFromContext(c); ok {
+			event.Metadata = map[string]interface{}{
+				"policy_effect": string(decision.Effect),
+			}
+			if decision.MatchedPolicyID != "" {
+				event.Metadata["matched_policy_id"] = decision.MatchedPolicyID
+			}
+			if len(decision.Obligations) > 0 {
+				event.Metadata["policy_obligations"] = decision.Obligations
+			}
+
+			// Fold the decision into the request's span too, so a trace
+			// viewer shows which policy fired without cross-referencing
+			// the audit log.
+			if spanVal, exists := c.Get("span"); exists {
+				if span, ok := spanVal.(trace.Span); ok {
+					attrs := []attribute.KeyValue{
+						attribute.String("policy.effect", string(decision.Effect)),
+					}
+					if decision.MatchedPolicyID != "" {
+						attrs = append(attrs, attribute.String("policy.matched_id", decision.MatchedPolicyID))
+					}
+					span.AddEvent("policy.decision", trace.WithAttributes(attrs...))
+				}
+			}
+		}
+
 		// Log the event
 		if err := logger.Log(event); err != nil {
-			// Log to stderr if audit logging fails (don't fail the request)
-			fmt.Fprintf(os.Stderr, "Failed to write audit log: %v\n", err)
+			// Route the failure through the shared logging pipeline rather
+			// than failing the request.
+			logging.Get("audit").Error("failed to write audit log: %v", err)
 		}
 	}
 }
 
+// captureRequestBody reads and restores c.Request.Body so handlers still
+// see the full payload, returning at most maxBytes of it for the audit
+// event. The size cap only bounds what's retained for logging; the
+// handler-visible body is never truncated.
+func captureRequestBody(c *gin.Context, maxBytes int) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+
+	data, err := io.ReadAll(c.Request.Body)
+	c.Request.Body.Close()
+	if err != nil {
+		return ""
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(data))
+
+	if len(data) > maxBytes {
+		data = data[:maxBytes]
+	}
+	return string(data)
+}
+
 // determineAction extracts a human-readable action from method and path
 func determineAction(method, path string) string {
 	switch method {
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// queryAuditEventsHandler serves GET /api/v1/audit/events, a
+// filter-combining, cursor-paginated counterpart to GET /api/v1/audit (see
+// queryAuditHandler): where that endpoint picks one Find* method and
+// filters the rest locally, this one delegates straight to
+// cp.auditQueryUC, which can combine every AuditQuery filter and page
+// through results larger than one response via Cursor. As with
+// queryAuditHandler, non-admin callers are restricted to their own tenant
+// regardless of what the tenant_id param asks for.
+//
+// The next page's cursor and whether one exists are returned both as
+// X-Next-Cursor/X-Has-More response headers, for callers that only need
+// to paginate and would rather not parse the body, and in the JSON body
+// itself.
+func (cp *ControlPlaneV1) queryAuditEventsHandler(c *gin.Context) {
+	auth, err := GetAuthContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized", "message": "authentication required"})
+		return
+	}
+
+	var req dto.AuditQueryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "message": err.Error()})
+		return
+	}
+
+	if !RoleHasPermission(auth.Role, entities.PermissionAdmin) {
+		// Non-admins see only their own tenant's events, no matter what
+		// tenant_id param they passed.
+		req.TenantID = auth.TenantID
+	}
+
+	resp, err := cp.auditQueryUC.Execute(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if resp.NextCursor != "" {
+		c.Writer.Header().Set("X-Next-Cursor", resp.NextCursor)
+	}
+	if resp.HasMore {
+		c.Writer.Header().Set("X-Has-More", "true")
+	} else {
+		c.Writer.Header().Set("X-Has-More", "false")
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultAuditQueryLimit bounds an unbounded audit query (no explicit
+// limit param) to a sane page size.
+const defaultAuditQueryLimit = 100
+
+// defaultAuditQueryWindow is how far back queryAuditHandler looks when the
+// caller supplies none of user, tenant, from/to, or errors_only.
+const defaultAuditQueryWindow = 24 * time.Hour
+
+// queryAuditHandler serves GET /api/v1/audit?user=&tenant=&from=&to=&errors_only=&limit=
+// by delegating to whichever of cp.auditRepo's Find* methods best matches
+// the supplied filters, then applying any remaining filters locally.
+// Non-admin callers are restricted to their own tenant regardless of what
+// the tenant param asks for. Results stream as NDJSON (one AuditEvent per
+// line) instead of a single JSON array, so a large result set doesn't
+// require the client to buffer the whole response before processing any
+// of it.
+func (cp *ControlPlaneV1) queryAuditHandler(c *gin.Context) {
+	auth, err := GetAuthContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized", "message": "authentication required"})
+		return
+	}
+
+	start := time.Now()
+	defer func() {
+		cp.metrics.AuditQueryDuration.Observe(time.Since(start).Seconds())
+	}()
+
+	q := c.Request.URL.Query()
+	userID := q.Get("user")
+	tenantID := q.Get("tenant")
+	errorsOnly := q.Get("errors_only") == "true"
+
+	limit := defaultAuditQueryLimit
+	if raw := q.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = n
+	}
+
+	var from, to time.Time
+	if raw := q.Get("from"); raw != "" {
+		from, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from: " + err.Error()})
+			return
+		}
+	}
+	if raw := q.Get("to"); raw != "" {
+		to, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to: " + err.Error()})
+			return
+		}
+	}
+
+	if !RoleHasPermission(auth.Role, entities.PermissionAdmin) {
+		// Non-admins see only their own tenant's events, no matter what
+		// tenant param they passed.
+		tenantID = auth.TenantID
+	}
+
+	events, err := cp.findAuditEvents(userID, tenantID, from, to, errorsOnly, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to query audit events", "message": err.Error()})
+		return
+	}
+
+	c.Writer.Header().Set("Content-Type", "application/x-ndjson")
+	c.Writer.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return
+		}
+		if flusher, ok := c.Writer.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+}
+
+// findAuditEvents picks the AuditRepository method best matching the
+// supplied filters and applies whichever filters that method doesn't
+// already cover locally, since AuditRepository exposes single-dimension
+// lookups rather than a combined query.
+func (cp *ControlPlaneV1) findAuditEvents(userID, tenantID string, from, to time.Time, errorsOnly bool, limit int) ([]*entities.AuditEvent, error) {
+	var (
+		events []*entities.AuditEvent
+		err    error
+	)
+
+	switch {
+	case errorsOnly:
+		events, err = cp.auditRepo.FindErrors(limit)
+	case userID != "":
+		events, err = cp.auditRepo.FindByUser(userID, limit)
+	case !from.IsZero() || !to.IsZero():
+		rangeEnd := to
+		if rangeEnd.IsZero() {
+			rangeEnd = time.Now()
+		}
+		events, err = cp.auditRepo.FindByTimeRange(from, rangeEnd)
+	case tenantID != "":
+		events, err = cp.auditRepo.FindByTenant(tenantID, limit)
+	default:
+		events, err = cp.auditRepo.FindByTimeRange(time.Now().Add(-defaultAuditQueryWindow), time.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := events[:0]
+	for _, event := range events {
+		if tenantID != "" && event.TenantID != tenantID {
+			continue
+		}
+		if userID != "" && event.UserID != userID {
+			continue
+		}
+		if !from.IsZero() && event.Timestamp.Before(from) {
+			continue
+		}
+		if !to.IsZero() && event.Timestamp.After(to) {
+			continue
+		}
+		if errorsOnly && !event.IsError() {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+	if limit > 0 && len(filtered) > limit {
+		filtered = filtered[:limit]
+	}
+	return filtered, nil
+}
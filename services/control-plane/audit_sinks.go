@@ -0,0 +1,429 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jackc/pgx/v5/pgxpool"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"github.com/segmentio/kafka-go"
+)
+
+// DefaultAuditQueueSize is how many events an asyncSink buffers before it
+// starts dropping events rather than blocking the caller.
+const DefaultAuditQueueSize = 256
+
+// asyncSink wraps an AuditSink with a bounded queue and a single background
+// goroutine, so a slow downstream (a flaky Kafka broker, a stalled S3
+// upload) can never block the Gin request path. Once the queue fills,
+// Log drops the event and reports an error instead of blocking.
+type asyncSink struct {
+	label string
+	sink  AuditSink
+	queue chan AuditEvent
+	wg    sync.WaitGroup
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func newAsyncSink(label string, sink AuditSink, queueSize int) *asyncSink {
+	if queueSize <= 0 {
+		queueSize = DefaultAuditQueueSize
+	}
+	a := &asyncSink{
+		label: label,
+		sink:  sink,
+		queue: make(chan AuditEvent, queueSize),
+	}
+	a.wg.Add(1)
+	go a.run()
+	return a
+}
+
+func (a *asyncSink) run() {
+	defer a.wg.Done()
+	for event := range a.queue {
+		if err := a.sink.Log(event); err != nil {
+			fmt.Fprintf(os.Stderr, "audit sink %s: %v\n", a.label, err)
+		}
+	}
+}
+
+// Log enqueues event for delivery and returns immediately. If the queue is
+// full the event is dropped and an error is returned so the caller can
+// surface it, but the request path is never blocked.
+func (a *asyncSink) Log(event AuditEvent) error {
+	select {
+	case a.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("audit sink %s: queue full, dropping event", a.label)
+	}
+}
+
+// Close drains whatever is already queued, then closes the underlying sink.
+// Calls after the first are no-ops that return the first call's result.
+func (a *asyncSink) Close() error {
+	a.closeOnce.Do(func() {
+		close(a.queue)
+		a.wg.Wait()
+		a.closeErr = a.sink.Close()
+	})
+	return a.closeErr
+}
+
+// SyslogSinkConfig configures a SyslogAuditSink. Network and Address empty
+// dial the local syslog daemon; set them (e.g. "udp", "collector:514") to
+// ship to a remote syslog/SIEM endpoint.
+type SyslogSinkConfig struct {
+	Network string
+	Address string
+	Tag     string
+}
+
+// SyslogAuditSink ships audit events to syslog, one JSON-encoded event per
+// message. It relies on the standard library's syslog writer for framing
+// and only supplies the message body; that writer emits a BSD-style
+// "<PRI>TIMESTAMP HOSTNAME TAG[PID]:" header, not RFC 5424 framing (no
+// version field, no structured data).
+type SyslogAuditSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogAuditSink dials the syslog destination described by cfg.
+func NewSyslogAuditSink(cfg SyslogSinkConfig) (*SyslogAuditSink, error) {
+	tag := cfg.Tag
+	if tag == "" {
+		tag = "allsource-control-plane"
+	}
+	writer, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_AUTH|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, fmt.Errorf("dial syslog: %w", err)
+	}
+	return &SyslogAuditSink{writer: writer}, nil
+}
+
+// Log writes event to syslog, at the Err level for failed requests and
+// panics, Info otherwise.
+func (s *SyslogAuditSink) Log(event AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event for syslog: %w", err)
+	}
+	if event.StatusCode >= 500 || event.Error != "" {
+		return s.writer.Err(string(data))
+	}
+	return s.writer.Info(string(data))
+}
+
+func (s *SyslogAuditSink) Close() error {
+	return s.writer.Close()
+}
+
+// KafkaSinkConfig configures a KafkaAuditSink.
+type KafkaSinkConfig struct {
+	Brokers []string
+	Topic   string
+}
+
+// KafkaAuditSink ships audit events as JSON-encoded Kafka messages, keyed
+// by tenant so a consumer can partition by tenant for ordering.
+type KafkaAuditSink struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaAuditSink constructs a producer for cfg.Topic across cfg.Brokers.
+func NewKafkaAuditSink(cfg KafkaSinkConfig) (*KafkaAuditSink, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka audit sink: at least one broker is required")
+	}
+	if cfg.Topic == "" {
+		return nil, fmt.Errorf("kafka audit sink: topic is required")
+	}
+	return &KafkaAuditSink{
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(cfg.Brokers...),
+			Topic:    cfg.Topic,
+			Balancer: &kafka.LeastBytes{},
+		},
+	}, nil
+}
+
+func (k *KafkaAuditSink) Log(event AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event for kafka: %w", err)
+	}
+	return k.writer.WriteMessages(context.Background(), kafka.Message{
+		Key:   []byte(event.TenantID),
+		Value: data,
+	})
+}
+
+func (k *KafkaAuditSink) Close() error {
+	return k.writer.Close()
+}
+
+// OTLPSinkConfig configures an OTLPAuditSink.
+type OTLPSinkConfig struct {
+	Endpoint string
+	Insecure bool
+}
+
+// OTLPAuditSink exports audit events as OpenTelemetry log records over
+// OTLP/gRPC, so they land alongside traces and metrics in the same
+// observability backend.
+type OTLPAuditSink struct {
+	provider *sdklog.LoggerProvider
+	logger   otellog.Logger
+}
+
+// NewOTLPAuditSink dials cfg.Endpoint and builds a batching OTLP log exporter.
+func NewOTLPAuditSink(cfg OTLPSinkConfig) (*OTLPAuditSink, error) {
+	opts := []otlploggrpc.Option{otlploggrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlploggrpc.WithInsecure())
+	}
+
+	exporter, err := otlploggrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP log exporter: %w", err)
+	}
+
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)))
+	return &OTLPAuditSink{
+		provider: provider,
+		logger:   provider.Logger(serviceName),
+	}, nil
+}
+
+func (o *OTLPAuditSink) Log(event AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event for OTLP: %w", err)
+	}
+
+	var record otellog.Record
+	record.SetTimestamp(event.Timestamp)
+	record.SetSeverity(otlpSeverity(event))
+	record.SetBody(otellog.StringValue(string(data)))
+	record.AddAttributes(
+		otellog.String("event_type", event.EventType),
+		otellog.String("tenant_id", event.TenantID),
+	)
+
+	o.logger.Emit(context.Background(), record)
+	return nil
+}
+
+func otlpSeverity(event AuditEvent) otellog.Severity {
+	if event.StatusCode >= 500 || event.Error != "" {
+		return otellog.SeverityError
+	}
+	return otellog.SeverityInfo
+}
+
+func (o *OTLPAuditSink) Close() error {
+	return o.provider.Shutdown(context.Background())
+}
+
+// Defaults for S3AuditSink rollover.
+const (
+	DefaultS3SegmentSize    = 64 * 1024 * 1024
+	DefaultS3RotateInterval = 15 * time.Minute
+)
+
+// S3SinkConfig configures an S3AuditSink.
+type S3SinkConfig struct {
+	Bucket         string
+	Prefix         string
+	Client         *s3.Client
+	MaxSegmentSize int64
+	RotateEvery    time.Duration
+}
+
+// S3AuditSink buffers audit events into an in-memory segment, gzips it, and
+// uploads it to S3 once the segment grows past MaxSegmentSize or
+// RotateEvery elapses, whichever comes first. Both limits are checked only
+// as each event is logged, so an idle segment stays open until the next
+// event or Close.
+type S3AuditSink struct {
+	cfg          S3SinkConfig
+	mu           sync.Mutex
+	buf          bytes.Buffer
+	segmentStart time.Time
+	segmentSeq   int
+}
+
+// NewS3AuditSink prepares a rotating sink that uploads closed segments via cfg.Client.
+func NewS3AuditSink(cfg S3SinkConfig) (*S3AuditSink, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 audit sink: bucket is required")
+	}
+	if cfg.Client == nil {
+		return nil, fmt.Errorf("s3 audit sink: client is required")
+	}
+	if cfg.MaxSegmentSize <= 0 {
+		cfg.MaxSegmentSize = DefaultS3SegmentSize
+	}
+	if cfg.RotateEvery <= 0 {
+		cfg.RotateEvery = DefaultS3RotateInterval
+	}
+	return &S3AuditSink{cfg: cfg, segmentStart: time.Now()}, nil
+}
+
+func (s *S3AuditSink) Log(event AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event for s3: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buf.Write(data)
+	s.buf.WriteByte('\n')
+
+	if int64(s.buf.Len()) >= s.cfg.MaxSegmentSize || time.Since(s.segmentStart) >= s.cfg.RotateEvery {
+		return s.rotateLocked()
+	}
+	return nil
+}
+
+// rotateLocked gzips the current segment, uploads it, and starts a fresh
+// one. Callers must hold s.mu.
+func (s *S3AuditSink) rotateLocked() error {
+	if s.buf.Len() == 0 {
+		s.segmentStart = time.Now()
+		return nil
+	}
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	if _, err := zw.Write(s.buf.Bytes()); err != nil {
+		return fmt.Errorf("gzip audit segment: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("gzip audit segment: %w", err)
+	}
+
+	key := fmt.Sprintf("%s%s-%d.jsonl.gz", s.cfg.Prefix, s.segmentStart.UTC().Format("20060102T150405Z"), s.segmentSeq)
+	_, err := s.cfg.Client.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String(s.cfg.Bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(gz.Bytes()),
+	})
+	if err != nil {
+		return fmt.Errorf("upload audit segment to s3: %w", err)
+	}
+
+	s.buf.Reset()
+	s.segmentStart = time.Now()
+	s.segmentSeq++
+	return nil
+}
+
+// Close flushes and uploads whatever segment is still open.
+func (s *S3AuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rotateLocked()
+}
+
+// DefaultPostgresTable is the table NewPostgresAuditSink creates and
+// writes to when PostgresSinkConfig.Table is empty.
+const DefaultPostgresTable = "audit_events"
+
+// PostgresSinkConfig configures a PostgresAuditSink.
+type PostgresSinkConfig struct {
+	DSN   string
+	Table string
+}
+
+func (c PostgresSinkConfig) table() string {
+	if c.Table == "" {
+		return DefaultPostgresTable
+	}
+	return c.Table
+}
+
+// PostgresAuditSink writes audit events as rows in a Postgres table,
+// so they can be queried with SQL alongside other operational data
+// instead of only being grepped out of a log stream.
+type PostgresAuditSink struct {
+	pool  *pgxpool.Pool
+	table string
+}
+
+// NewPostgresAuditSink connects to cfg.DSN and ensures cfg.Table (or
+// DefaultPostgresTable) exists before returning.
+func NewPostgresAuditSink(ctx context.Context, cfg PostgresSinkConfig) (*PostgresAuditSink, error) {
+	if cfg.DSN == "" {
+		return nil, fmt.Errorf("postgres audit sink: DSN is required")
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("connect to postgres audit sink: %w", err)
+	}
+
+	sink := &PostgresAuditSink{pool: pool, table: cfg.table()}
+	if err := sink.ensureTable(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// ensureTable creates the sink's table if it doesn't already exist.
+// event_data holds the full JSON-encoded AuditEvent; event_type,
+// tenant_id, and recorded_at are broken out into their own columns so
+// they can be indexed and filtered without unpacking JSON.
+func (p *PostgresAuditSink) ensureTable(ctx context.Context) error {
+	_, err := p.pool.Exec(ctx, fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			id          BIGSERIAL PRIMARY KEY,
+			event_type  TEXT NOT NULL,
+			tenant_id   TEXT NOT NULL,
+			recorded_at TIMESTAMPTZ NOT NULL,
+			event_data  JSONB NOT NULL
+		)`, p.table))
+	if err != nil {
+		return fmt.Errorf("create postgres audit table: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresAuditSink) Log(event AuditEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event for postgres: %w", err)
+	}
+
+	_, err = p.pool.Exec(context.Background(),
+		fmt.Sprintf(`INSERT INTO %s (event_type, tenant_id, recorded_at, event_data) VALUES ($1, $2, $3, $4)`, p.table),
+		event.EventType, event.TenantID, event.Timestamp, data,
+	)
+	if err != nil {
+		return fmt.Errorf("insert audit event into postgres: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresAuditSink) Close() error {
+	p.pool.Close()
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"crypto/ed25519"
 	"encoding/json"
 	"os"
 	"strings"
@@ -17,7 +18,7 @@ func TestAuditLogger_Log(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	logger, err := NewAuditLogger(tmpfile.Name())
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
 	if err != nil {
 		t.Fatalf("Failed to create audit logger: %v", err)
 	}
@@ -95,7 +96,7 @@ func TestAuditLogger_MultipleEvents(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	logger, err := NewAuditLogger(tmpfile.Name())
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
 	if err != nil {
 		t.Fatalf("Failed to create audit logger: %v", err)
 	}
@@ -137,7 +138,7 @@ func TestAuditLogger_LogAuthEvent(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	logger, err := NewAuditLogger(tmpfile.Name())
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
 	if err != nil {
 		t.Fatalf("Failed to create audit logger: %v", err)
 	}
@@ -176,7 +177,7 @@ func TestAuditLogger_LogTenantEvent(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	logger, err := NewAuditLogger(tmpfile.Name())
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
 	if err != nil {
 		t.Fatalf("Failed to create audit logger: %v", err)
 	}
@@ -218,7 +219,7 @@ func TestAuditLogger_LogOperationEvent(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	logger, err := NewAuditLogger(tmpfile.Name())
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
 	if err != nil {
 		t.Fatalf("Failed to create audit logger: %v", err)
 	}
@@ -253,14 +254,14 @@ func TestAuditLogger_LogOperationEvent(t *testing.T) {
 }
 
 func TestAuditLogger_Disabled(t *testing.T) {
-	// Create logger with empty path (disabled)
-	logger, err := NewAuditLogger("")
+	// Create logger with no sinks configured (disabled)
+	logger, err := NewAuditLogger(AuditConfig{})
 	if err != nil {
 		t.Fatalf("Failed to create disabled logger: %v", err)
 	}
 
-	if logger.enabled {
-		t.Error("Logger should be disabled when path is empty")
+	if logger.Enabled() {
+		t.Error("Logger should be disabled when no sinks are configured")
 	}
 
 	// Logging should succeed but do nothing
@@ -334,7 +335,7 @@ func TestAuditLogger_Concurrency(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	logger, err := NewAuditLogger(tmpfile.Name())
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
 	if err != nil {
 		t.Fatalf("Failed to create audit logger: %v", err)
 	}
@@ -378,3 +379,184 @@ func TestAuditLogger_Concurrency(t *testing.T) {
 		t.Errorf("Expected %d log lines, got %d", expectedLines, len(lines))
 	}
 }
+
+func TestAuditLogger_HashChaining(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "audit-test-*.log")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpfile.Name())
+	defer tmpfile.Close()
+
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := logger.Log(AuditEvent{EventType: "test_event", Action: "test"}); err != nil {
+			t.Fatalf("Log() failed: %v", err)
+		}
+	}
+	logger.Close()
+
+	events, err := VerifyAuditLog(tmpfile.Name(), nil)
+	if err != nil {
+		t.Fatalf("VerifyAuditLog() failed: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+	if events[0].PrevHash != "" {
+		t.Error("First event should have an empty PrevHash")
+	}
+	for i := 1; i < len(events); i++ {
+		if events[i].PrevHash != events[i-1].Hash {
+			t.Errorf("Event %d PrevHash = %s, want %s", i, events[i].PrevHash, events[i-1].Hash)
+		}
+	}
+}
+
+func TestAuditLogger_SigningAndVerify(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "audit-test-*.log")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpfile.Name())
+	defer tmpfile.Close()
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name(), SigningKey: priv, CheckpointEvery: 2})
+	if err != nil {
+		t.Fatalf("Failed to create signing audit logger: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := logger.Log(AuditEvent{EventType: "test_event", Action: "test"}); err != nil {
+			t.Fatalf("Log() failed: %v", err)
+		}
+	}
+	logger.Close()
+
+	events, err := VerifyAuditLog(tmpfile.Name(), pub)
+	if err != nil {
+		t.Fatalf("VerifyAuditLog() failed: %v", err)
+	}
+	// 2 logged events plus a checkpoint emitted after the 2nd.
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events (including checkpoint), got %d", len(events))
+	}
+	if events[len(events)-1].EventType != "audit.checkpoint" {
+		t.Errorf("Expected trailing checkpoint record, got %s", events[len(events)-1].EventType)
+	}
+	for _, e := range events {
+		if e.Signature == "" {
+			t.Errorf("Event %s should be signed", e.EventType)
+		}
+	}
+}
+
+func TestVerifyAuditLog_DetectsTampering(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "audit-test-*.log")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpfile.Name())
+	defer tmpfile.Close()
+
+	logger, err := NewAuditLogger(AuditConfig{FilePath: tmpfile.Name()})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := logger.Log(AuditEvent{EventType: "test_event", Action: "test"}); err != nil {
+			t.Fatalf("Log() failed: %v", err)
+		}
+	}
+	logger.Close()
+
+	content, err := os.ReadFile(tmpfile.Name())
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	tampered := strings.Replace(string(content), "test_event", "tampered_event", 1)
+	if err := os.WriteFile(tmpfile.Name(), []byte(tampered), 0644); err != nil {
+		t.Fatalf("Failed to write tampered log: %v", err)
+	}
+
+	if _, err := VerifyAuditLog(tmpfile.Name(), nil); err == nil {
+		t.Error("Expected VerifyAuditLog to detect tampering")
+	}
+}
+
+func TestAuditLogger_ShouldCaptureBody(t *testing.T) {
+	logger, err := NewAuditLogger(AuditConfig{
+		Context: &ContextConfig{
+			CaptureResources: []string{"tenant"},
+			DenyPaths:        []string{"/tenants/secret"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+
+	tests := []struct {
+		resource string
+		path     string
+		want     bool
+	}{
+		{"tenant", "/tenants", true},
+		{"tenant", "/tenants/secret", false},
+		{"snapshot", "/snapshots", false},
+	}
+	for _, tt := range tests {
+		if got := logger.shouldCaptureBody(tt.resource, tt.path); got != tt.want {
+			t.Errorf("shouldCaptureBody(%q, %q) = %v, want %v", tt.resource, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAuditLogger_RedactBody(t *testing.T) {
+	logger, err := NewAuditLogger(AuditConfig{
+		Context: &ContextConfig{RedactFields: []string{"password", "token"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+
+	body := `{"username":"alice","password":"hunter2","nested":{"Token":"abc123"}}`
+	redacted := logger.redactBody(body)
+
+	if strings.Contains(redacted, "hunter2") || strings.Contains(redacted, "abc123") {
+		t.Errorf("redactBody() did not redact secrets: %s", redacted)
+	}
+	if !strings.Contains(redacted, "alice") {
+		t.Errorf("redactBody() redacted a non-sensitive field: %s", redacted)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(redacted), &parsed); err != nil {
+		t.Fatalf("redactBody() returned invalid JSON: %v", err)
+	}
+	if parsed["password"] != "[REDACTED]" {
+		t.Errorf("password = %v, want [REDACTED]", parsed["password"])
+	}
+}
+
+func TestAuditLogger_RedactBody_NonJSONLeftAsIs(t *testing.T) {
+	logger, err := NewAuditLogger(AuditConfig{
+		Context: &ContextConfig{RedactFields: []string{"password"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create audit logger: %v", err)
+	}
+
+	body := "not json"
+	if got := logger.redactBody(body); got != body {
+		t.Errorf("redactBody() = %q, want unchanged %q", got, body)
+	}
+}
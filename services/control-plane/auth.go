@@ -7,19 +7,20 @@ import (
 	"strings"
 	"time"
 
+	"github.com/allsource/control-plane/internal/cluster"
 	"github.com/allsource/control-plane/internal/domain/entities"
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 // Claims represents JWT claims
 type Claims struct {
-	UserID    string        `json:"sub"`
-	Username  string        `json:"username"`
-	TenantID  string        `json:"tenant_id"`
-	Role      entities.Role `json:"role"`
-	IsAPIKey  bool          `json:"is_api_key,omitempty"`
-	jwt.StandardClaims
+	UserID   string        `json:"sub"`
+	Username string        `json:"username"`
+	TenantID string        `json:"tenant_id"`
+	Role     entities.Role `json:"role"`
+	IsAPIKey bool          `json:"is_api_key,omitempty"`
+	jwt.RegisteredClaims
 }
 
 // AuthContext holds authentication information for a request
@@ -31,46 +32,124 @@ type AuthContext struct {
 	IsAPIKey bool
 }
 
-// AuthClient handles authentication with the core service
+// KeyProvider resolves the key that verifies a token's signature.
+// StaticKeyProvider serves a single pre-shared HMAC secret;
+// JWKSKeyProvider fetches RS256/ES256 public keys from a JWKS endpoint.
+type KeyProvider interface {
+	Key(token *jwt.Token) (interface{}, error)
+}
+
+// StaticKeyProvider returns the same HMAC secret for every token.
+type StaticKeyProvider struct {
+	Secret []byte
+}
+
+// Key implements KeyProvider.
+func (p StaticKeyProvider) Key(token *jwt.Token) (interface{}, error) {
+	return p.Secret, nil
+}
+
+// AuthClient validates JWT bearer tokens, either against a pre-shared
+// HMAC secret (NewAuthClient) or an OIDC provider's rotating JWKS
+// (NewAuthClientWithConfig).
 type AuthClient struct {
-	jwtSecret string
+	keys       KeyProvider
+	algorithms []string
+	issuer     string
+	audience   string
 }
 
-// NewAuthClient creates a new authentication client
+// NewAuthClient creates an AuthClient that verifies HS256 tokens signed
+// with jwtSecret. Use NewAuthClientWithConfig for JWKS-based
+// RS256/ES256 verification instead.
 func NewAuthClient(jwtSecret string) *AuthClient {
 	if jwtSecret == "" {
 		jwtSecret = "default-secret-change-in-production"
 	}
 	return &AuthClient{
-		jwtSecret: jwtSecret,
+		keys:       StaticKeyProvider{Secret: []byte(jwtSecret)},
+		algorithms: []string{"HS256"},
+	}
+}
+
+// AuthConfig configures an AuthClient built with NewAuthClientWithConfig.
+type AuthConfig struct {
+	// Secret is the pre-shared HMAC secret for HS256 verification. Set
+	// this, or JWKSURL, but not both.
+	Secret string
+	// JWKSURL is the OIDC provider's JSON Web Key Set endpoint, used for
+	// RS256/ES256 verification. Set this, or Secret, but not both.
+	JWKSURL string
+	// RefreshInterval controls how long a JWKS fetch is cached before
+	// being re-fetched; defaults to DefaultKeyRefresh if zero. Unused
+	// when Secret is set.
+	RefreshInterval time.Duration
+	// Algorithms is the allowlist of accepted `alg` header values. A
+	// token signed with any other algorithm, including "none", is
+	// rejected before KeyProvider.Key is even called. Defaults to
+	// {"HS256"} when Secret is set, or {"RS256", "ES256"} when JWKSURL
+	// is set.
+	Algorithms []string
+	// Issuer is the expected `iss` claim. Empty disables the check.
+	Issuer string
+	// Audience is the expected `aud` claim. Empty disables the check.
+	Audience string
+}
+
+// NewAuthClientWithConfig creates an AuthClient from cfg, for deployments
+// that verify tokens against an OIDC provider's key set instead of a
+// single pre-shared secret.
+func NewAuthClientWithConfig(cfg AuthConfig) (*AuthClient, error) {
+	if cfg.Secret == "" && cfg.JWKSURL == "" {
+		return nil, errors.New("auth config: one of Secret or JWKSURL is required")
+	}
+	if cfg.Secret != "" && cfg.JWKSURL != "" {
+		return nil, errors.New("auth config: Secret and JWKSURL are mutually exclusive")
+	}
+
+	client := &AuthClient{algorithms: cfg.Algorithms, issuer: cfg.Issuer, audience: cfg.Audience}
+	if cfg.Secret != "" {
+		client.keys = StaticKeyProvider{Secret: []byte(cfg.Secret)}
+		if len(client.algorithms) == 0 {
+			client.algorithms = []string{"HS256"}
+		}
+	} else {
+		client.keys = NewJWKSKeyProvider(cfg.JWKSURL, cfg.RefreshInterval)
+		if len(client.algorithms) == 0 {
+			client.algorithms = []string{"RS256", "ES256"}
+		}
+	}
+	return client, nil
+}
+
+// Close releases any background resources held by the AuthClient's
+// KeyProvider — the JWKS refresh goroutine, for a client built with a
+// JWKSURL. It's a no-op for a client built with a static secret.
+func (a *AuthClient) Close() {
+	if closer, ok := a.keys.(interface{ Close() }); ok {
+		closer.Close()
 	}
 }
 
 // ValidateToken validates a JWT token and returns claims
 func (a *AuthClient) ValidateToken(tokenString string) (*Claims, error) {
-	// Parse the token
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// Validate the signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(a.jwtSecret), nil
-	})
+	opts := []jwt.ParserOption{jwt.WithValidMethods(a.algorithms)}
+	if a.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(a.issuer))
+	}
+	if a.audience != "" {
+		opts = append(opts, jwt.WithAudience(a.audience))
+	}
 
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, a.keys.Key, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
-
-	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
+	if !token.Valid {
 		return nil, errors.New("invalid token")
 	}
 
-	// Check expiration
-	if claims.ExpiresAt < time.Now().Unix() {
-		return nil, errors.New("token expired")
-	}
-
 	return claims, nil
 }
 
@@ -102,8 +181,10 @@ func RoleHasPermission(role entities.Role, perm entities.Permission) bool {
 // AuthMiddleware validates JWT tokens and adds auth context to requests
 func AuthMiddleware(authClient *AuthClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Skip auth for health endpoints
-		if c.Request.URL.Path == "/health" || c.Request.URL.Path == "/metrics" {
+		// Skip auth for health endpoints and the peer gossip receiver, which
+		// authenticates via its own Ed25519 event signature instead of a JWT
+		// (see cluster.Verify).
+		if c.Request.URL.Path == "/health" || c.Request.URL.Path == "/metrics" || c.Request.URL.Path == cluster.NotifyPath {
 			c.Next()
 			return
 		}
@@ -5,7 +5,7 @@ import (
 	"time"
 
 	"github.com/allsource/control-plane/internal/domain/entities"
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 func TestAuthClient_ValidateToken(t *testing.T) {
@@ -18,9 +18,9 @@ func TestAuthClient_ValidateToken(t *testing.T) {
 		Username: "testuser",
 		TenantID: "default",
 		Role:     entities.RoleDeveloper,
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(time.Hour).Unix(),
-			IssuedAt:  time.Now().Unix(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
@@ -54,9 +54,9 @@ func TestAuthClient_ValidateToken(t *testing.T) {
 			Username: "testuser",
 			TenantID: "default",
 			Role:     entities.RoleDeveloper,
-			StandardClaims: jwt.StandardClaims{
-				ExpiresAt: time.Now().Add(-time.Hour).Unix(), // Already expired
-				IssuedAt:  time.Now().Unix(),
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)), // Already expired
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
 			},
 		}
 
@@ -0,0 +1,66 @@
+package dto
+
+import "time"
+
+// CreateAppRoleRequest represents a request to create a new AppRole
+type CreateAppRoleRequest struct {
+	TenantID        string   `json:"tenant_id" binding:"required"`
+	BoundPolicies   []string `json:"bound_policies"`
+	SecretIDTTL     string   `json:"secret_id_ttl,omitempty"`
+	SecretIDNumUses int      `json:"secret_id_num_uses,omitempty"`
+	TokenTTL        string   `json:"token_ttl,omitempty"`
+	BoundCIDRs      []string `json:"bound_cidrs,omitempty"`
+}
+
+// AppRoleResponse represents an AppRole returned to API callers
+type AppRoleResponse struct {
+	ID              string    `json:"id"`
+	RoleID          string    `json:"role_id"`
+	TenantID        string    `json:"tenant_id"`
+	BoundPolicies   []string  `json:"bound_policies"`
+	SecretIDTTL     string    `json:"secret_id_ttl"`
+	SecretIDNumUses int       `json:"secret_id_num_uses"`
+	TokenTTL        string    `json:"token_ttl"`
+	BoundCIDRs      []string  `json:"bound_cidrs,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// GenerateSecretIDRequest represents a request to issue a new SecretID
+// under an AppRole. A zero TTL or NumUses falls back to the AppRole's own
+// defaults.
+type GenerateSecretIDRequest struct {
+	TTL        string            `json:"ttl,omitempty"`
+	NumUses    int               `json:"num_uses,omitempty"`
+	BoundCIDRs []string          `json:"bound_cidrs,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// SecretIDResponse represents a newly generated SecretID returned to API
+// callers. SecretID is only ever populated on the response to the
+// generating call; it is never retrievable afterward.
+type SecretIDResponse struct {
+	ID            string     `json:"id"`
+	SecretID      string     `json:"secret_id,omitempty"`
+	AppRoleID     string     `json:"app_role_id"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	RemainingUses int        `json:"remaining_uses"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// AppRoleLoginRequest represents an AppRole login attempt
+type AppRoleLoginRequest struct {
+	RoleID   string `json:"role_id" binding:"required"`
+	SecretID string `json:"secret_id" binding:"required"`
+}
+
+// AppRoleLoginResult carries everything the caller needs to mint a JWT
+// after a successful AppRole login. It deliberately holds no JSON tags: it
+// isn't serialized directly, only consumed by the handler that mints the
+// token.
+type AppRoleLoginResult struct {
+	RoleID        string
+	TenantID      string
+	BoundPolicies []string
+	TokenTTL      time.Duration
+}
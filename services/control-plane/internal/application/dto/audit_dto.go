@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+// AuditQueryRequest represents a filtered, paginated audit event query.
+// Every field is optional; a zero value leaves that filter unapplied.
+type AuditQueryRequest struct {
+	UserID        string `form:"user_id"`
+	TenantID      string `form:"tenant_id"`
+	Resource      string `form:"resource"`
+	Action        string `form:"action"`
+	MinStatusCode int    `form:"min_status_code"`
+	MaxStatusCode int    `form:"max_status_code"`
+	// ErrorsOnly and SuccessOnly are mutually exclusive; setting both is a
+	// caller error the use case rejects rather than silently resolving.
+	ErrorsOnly  bool   `form:"errors_only"`
+	SuccessOnly bool   `form:"success_only"`
+	Metadata    string `form:"metadata"`
+	Since       string `form:"since"`
+	Until       string `form:"until"`
+	// SortOrder is "asc" or "desc"; empty defaults to "desc" (most recent
+	// first).
+	SortOrder string `form:"sort"`
+	Cursor    string `form:"cursor"`
+	PageSize  int    `form:"page_size"`
+}
+
+// AuditEventResponse represents a single audit event returned to API
+// callers.
+type AuditEventResponse struct {
+	ID         string                 `json:"id"`
+	Timestamp  time.Time              `json:"timestamp"`
+	EventType  string                 `json:"event_type"`
+	UserID     string                 `json:"user_id,omitempty"`
+	Username   string                 `json:"username,omitempty"`
+	TenantID   string                 `json:"tenant_id,omitempty"`
+	Action     string                 `json:"action"`
+	Resource   string                 `json:"resource,omitempty"`
+	ResourceID string                 `json:"resource_id,omitempty"`
+	Method     string                 `json:"method,omitempty"`
+	Path       string                 `json:"path,omitempty"`
+	StatusCode int                    `json:"status_code"`
+	Duration   float64                `json:"duration_ms"`
+	IPAddress  string                 `json:"ip_address,omitempty"`
+	UserAgent  string                 `json:"user_agent,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// AuditPageResponse is one page of an AuditQueryRequest.
+type AuditPageResponse struct {
+	Events     []AuditEventResponse `json:"events"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+	HasMore    bool                 `json:"has_more"`
+}
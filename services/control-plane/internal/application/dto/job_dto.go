@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+// CreateJobRequest represents a request to enqueue a new asynchronous job
+type CreateJobRequest struct {
+	Type    string                 `json:"type" binding:"required"`
+	Options map[string]interface{} `json:"options"`
+	// CronStr, if set, is a Go duration string (e.g. "1h", "30m") on which
+	// the job re-triggers; omit for a one-off run.
+	CronStr string `json:"cron_str,omitempty"`
+	// TriggeredBy identifies the actor requesting the job; defaults to
+	// "api" if omitted.
+	TriggeredBy string `json:"triggered_by,omitempty"`
+}
+
+// JobResponse represents a job returned to API callers
+type JobResponse struct {
+	ID           string                 `json:"id"`
+	Type         string                 `json:"type"`
+	Status       string                 `json:"status"`
+	Options      map[string]interface{} `json:"options,omitempty"`
+	CreationTime time.Time              `json:"creation_time"`
+	UpdateTime   time.Time              `json:"update_time"`
+	StartTime    *time.Time             `json:"start_time,omitempty"`
+	CronStr      string                 `json:"cron_str,omitempty"`
+	TriggeredBy  string                 `json:"triggered_by"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// JobExecutionResponse represents a single run of a job returned to API
+// callers, covering both scheduled and manually triggered runs.
+type JobExecutionResponse struct {
+	ID           string     `json:"id"`
+	JobID        string     `json:"job_id"`
+	Status       string     `json:"status"`
+	Stdout       string     `json:"stdout,omitempty"`
+	Stderr       string     `json:"stderr,omitempty"`
+	ExitCode     int        `json:"exit_code"`
+	TraceID      string     `json:"trace_id,omitempty"`
+	CreationTime time.Time  `json:"creation_time"`
+	UpdateTime   time.Time  `json:"update_time"`
+	StartTime    *time.Time `json:"start_time,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+}
@@ -2,13 +2,19 @@ package dto
 
 // CreatePolicyRequest represents a request to create a policy
 type CreatePolicyRequest struct {
-	ID          string              `json:"id" binding:"required"`
-	Name        string              `json:"name" binding:"required"`
-	Description string              `json:"description"`
-	Resource    string              `json:"resource" binding:"required"`
-	Action      string              `json:"action" binding:"required"`
-	Conditions  []PolicyConditionDTO `json:"conditions"`
-	Priority    int                 `json:"priority"`
+	ID                 string                 `json:"id" binding:"required"`
+	Name               string                 `json:"name" binding:"required"`
+	Description        string                 `json:"description"`
+	Resource           string                 `json:"resource" binding:"required"`
+	Action             string                 `json:"action" binding:"required"`
+	Conditions         []PolicyConditionDTO   `json:"conditions"`
+	Priority           int                    `json:"priority"`
+	Expression         string                 `json:"expression,omitempty"`
+	ExpressionLanguage string                 `json:"expression_language,omitempty"`
+	Obligations        map[string]interface{} `json:"obligations,omitempty"`
+	Advice             map[string]interface{} `json:"advice,omitempty"`
+	Engine             string                 `json:"engine,omitempty"`
+	ModuleSource       string                 `json:"module_source,omitempty"`
 }
 
 // PolicyConditionDTO represents a policy condition
@@ -20,35 +26,144 @@ type PolicyConditionDTO struct {
 
 // UpdatePolicyRequest represents a request to update a policy
 type UpdatePolicyRequest struct {
-	Name        string              `json:"name"`
-	Description string              `json:"description"`
-	Conditions  []PolicyConditionDTO `json:"conditions"`
-	Priority    int                 `json:"priority"`
-	Enabled     *bool               `json:"enabled"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Conditions         []PolicyConditionDTO   `json:"conditions"`
+	Priority           int                    `json:"priority"`
+	Enabled            *bool                  `json:"enabled"`
+	Expression         string                 `json:"expression,omitempty"`
+	ExpressionLanguage string                 `json:"expression_language,omitempty"`
+	Obligations        map[string]interface{} `json:"obligations,omitempty"`
+	Advice             map[string]interface{} `json:"advice,omitempty"`
+	Engine             string                 `json:"engine,omitempty"`
+	ModuleSource       string                 `json:"module_source,omitempty"`
 }
 
 // PolicyResponse represents a policy response
 type PolicyResponse struct {
-	ID          string              `json:"id"`
-	Name        string              `json:"name"`
-	Description string              `json:"description"`
-	Resource    string              `json:"resource"`
-	Action      string              `json:"action"`
-	Conditions  []PolicyConditionDTO `json:"conditions"`
-	Priority    int                 `json:"priority"`
-	Enabled     bool                `json:"enabled"`
+	ID                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Resource           string                 `json:"resource"`
+	Action             string                 `json:"action"`
+	Conditions         []PolicyConditionDTO   `json:"conditions"`
+	Priority           int                    `json:"priority"`
+	Enabled            bool                   `json:"enabled"`
+	Expression         string                 `json:"expression,omitempty"`
+	ExpressionLanguage string                 `json:"expression_language,omitempty"`
+	Obligations        map[string]interface{} `json:"obligations,omitempty"`
+	Advice             map[string]interface{} `json:"advice,omitempty"`
+	Engine             string                 `json:"engine,omitempty"`
+	ModuleSource       string                 `json:"module_source,omitempty"`
 }
 
-// EvaluatePolicyRequest represents a request to evaluate policies
+// EvaluatePolicyRequest represents a request to evaluate policies.
+// CombiningAlgorithm selects how multiple matched policies are combined
+// into one decision ("deny-overrides", "permit-overrides",
+// "first-applicable", or "deny-unless-permit"); empty defaults to
+// "deny-overrides".
 type EvaluatePolicyRequest struct {
-	Resource   string                 `json:"resource" binding:"required"`
-	Attributes map[string]interface{} `json:"attributes" binding:"required"`
+	Resource           string                 `json:"resource" binding:"required"`
+	Attributes         map[string]interface{} `json:"attributes" binding:"required"`
+	CombiningAlgorithm string                 `json:"combining_algorithm,omitempty"`
 }
 
-// EvaluatePolicyResponse represents a policy evaluation response
+// PolicyObligationDTO pairs the policy that contributed an Obligations or
+// Advice bag with that bag's contents.
+type PolicyObligationDTO struct {
+	PolicyID string                 `json:"policy_id"`
+	Values   map[string]interface{} `json:"values"`
+}
+
+// EvaluatePolicyResponse represents a policy evaluation response.
+// Obligations and Advice are collected from every matched policy, not
+// just the one named by MatchedID.
 type EvaluatePolicyResponse struct {
-	Allowed   bool     `json:"allowed"`
-	MatchedID string   `json:"matched_policy_id,omitempty"`
-	Action    string   `json:"action,omitempty"`
-	Reasons   []string `json:"reasons,omitempty"`
+	Allowed     bool                  `json:"allowed"`
+	MatchedID   string                `json:"matched_policy_id,omitempty"`
+	Action      string                `json:"action,omitempty"`
+	Reasons     []string              `json:"reasons,omitempty"`
+	Obligations []PolicyObligationDTO `json:"obligations,omitempty"`
+	Advice      []PolicyObligationDTO `json:"advice,omitempty"`
+}
+
+// UnbindPolicyRequest removes a subject from a policy's bindings: the
+// condition on Field (expected to be "tenant_id" or "user_id") matching
+// Value, or, for an "in" condition, just Value out of the list.
+type UnbindPolicyRequest struct {
+	Field string `json:"field" binding:"required"`
+	Value string `json:"value" binding:"required"`
+}
+
+// PolicyBindingDTO describes one subject a policy's Conditions bind it to.
+type PolicyBindingDTO struct {
+	Field string `json:"field"` // "tenant_id" or "user_id"
+	Value string `json:"value"`
+}
+
+// PolicyBindingsResponse lists every subject a policy currently applies to,
+// derived from its tenant_id/user_id Conditions.
+type PolicyBindingsResponse struct {
+	PolicyID string             `json:"policy_id"`
+	Bindings []PolicyBindingDTO `json:"bindings"`
+}
+
+// SimulatePolicyRequest is identical in shape to EvaluatePolicyRequest,
+// including CombiningAlgorithm, but is evaluated via SimulatePolicyUseCase,
+// which returns every considered policy instead of stopping at the first
+// match.
+type SimulatePolicyRequest struct {
+	Resource           string                 `json:"resource" binding:"required"`
+	Attributes         map[string]interface{} `json:"attributes" binding:"required"`
+	CombiningAlgorithm string                 `json:"combining_algorithm,omitempty"`
+}
+
+// PolicyTraceDTO describes whether a single policy matched during
+// simulation, and which of its conditions were responsible.
+type PolicyTraceDTO struct {
+	PolicyID         string               `json:"policy_id"`
+	Name             string               `json:"name"`
+	Priority         int                  `json:"priority"`
+	Action           string               `json:"action"`
+	Matched          bool                 `json:"matched"`
+	ConditionResults []bool               `json:"condition_results"`
+	Conditions       []PolicyConditionDTO `json:"conditions"`
+}
+
+// SimulatePolicyResponse is the full decision trace for a simulated
+// request: the decision that would be returned by EvaluatePolicyUseCase,
+// plus every policy that was considered along the way.
+type SimulatePolicyResponse struct {
+	Allowed        bool             `json:"allowed"`
+	MatchedID      string           `json:"matched_policy_id,omitempty"`
+	Action         string           `json:"action,omitempty"`
+	PoliciesTraced []PolicyTraceDTO `json:"policies_traced"`
+}
+
+// TestPolicyRequest dry-runs a policy definition against a sample input
+// without ever persisting it, so an operator can validate a Rego module
+// (or a builtin Conditions/Expression combination) before saving it. User/
+// Tenant/Operation, when set, are copied into Attributes as user_id/
+// tenant_id/operation before evaluation, for either engine.
+type TestPolicyRequest struct {
+	Resource           string                 `json:"resource" binding:"required"`
+	Action             string                 `json:"action" binding:"required"`
+	Conditions         []PolicyConditionDTO   `json:"conditions,omitempty"`
+	Expression         string                 `json:"expression,omitempty"`
+	ExpressionLanguage string                 `json:"expression_language,omitempty"`
+	Engine             string                 `json:"engine,omitempty"`
+	ModuleSource       string                 `json:"module_source,omitempty"`
+	User               string                 `json:"user,omitempty"`
+	Tenant             string                 `json:"tenant,omitempty"`
+	Operation          string                 `json:"operation,omitempty"`
+	Attributes         map[string]interface{} `json:"attributes"`
+}
+
+// TestPolicyResponse reports whether the policy under test matched the
+// sample input, or the error evaluating it produced (e.g. a Rego compile
+// failure), never both.
+type TestPolicyResponse struct {
+	Matched bool   `json:"matched"`
+	Action  string `json:"action,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
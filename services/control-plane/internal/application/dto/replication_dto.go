@@ -0,0 +1,68 @@
+package dto
+
+import "time"
+
+// CreateReplicationTargetRequest represents a request to register a peer
+// control-plane instance as a replication target.
+type CreateReplicationTargetRequest struct {
+	Name        string `json:"name" binding:"required"`
+	URL         string `json:"url" binding:"required"`
+	Credentials string `json:"credentials,omitempty"`
+}
+
+// ReplicationTargetResponse represents a replication target returned to
+// API callers.
+type ReplicationTargetResponse struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	URL          string    `json:"url"`
+	Enabled      bool      `json:"enabled"`
+	CreationTime time.Time `json:"creation_time"`
+	UpdateTime   time.Time `json:"update_time"`
+}
+
+// CreateReplicationPolicyRequest represents a request to schedule
+// replication of this node's policies to a target.
+type CreateReplicationPolicyRequest struct {
+	TargetID string `json:"target_id" binding:"required"`
+	// CronStr, if set, is a standard 5-field cron expression on which the
+	// policy re-triggers; omit for manual-trigger-only.
+	CronStr string `json:"cron_str,omitempty"`
+	// Priority decides which of two policies pushing to the same target
+	// wins when their bundles conflict; higher wins.
+	Priority int `json:"priority,omitempty"`
+	// TriggeredBy identifies the actor creating the policy; defaults to
+	// "api" if omitted.
+	TriggeredBy string `json:"triggered_by,omitempty"`
+}
+
+// ReplicationPolicyResponse represents a replication policy returned to
+// API callers.
+type ReplicationPolicyResponse struct {
+	ID             string    `json:"id"`
+	SourceResource string    `json:"source_resource"`
+	TargetID       string    `json:"target_id"`
+	CronStr        string    `json:"cron_str,omitempty"`
+	Priority       int       `json:"priority"`
+	TriggeredBy    string    `json:"triggered_by"`
+	Enabled        bool      `json:"enabled"`
+	CreationTime   time.Time `json:"creation_time"`
+	UpdateTime     time.Time `json:"update_time"`
+}
+
+// ReplicationExecutionResponse represents a single replication run
+// returned to API callers, covering both scheduled and manually
+// triggered runs.
+type ReplicationExecutionResponse struct {
+	ID           string     `json:"id"`
+	PolicyID     string     `json:"policy_id"`
+	TargetID     string     `json:"target_id"`
+	Status       string     `json:"status"`
+	Version      uint64     `json:"version"`
+	Attempt      int        `json:"attempt"`
+	Error        string     `json:"error,omitempty"`
+	CreationTime time.Time  `json:"creation_time"`
+	UpdateTime   time.Time  `json:"update_time"`
+	StartTime    *time.Time `json:"start_time,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+}
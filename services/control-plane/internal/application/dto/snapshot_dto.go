@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// ListSnapshotsRequest represents a request to page through snapshot manifests
+type ListSnapshotsRequest struct {
+	TenantID  string `form:"tenant_id"`
+	PageSize  int    `form:"page_size"`
+	PageToken string `form:"page_token"`
+}
+
+// SnapshotResponse represents a snapshot manifest returned to API callers
+type SnapshotResponse struct {
+	ID           string    `json:"id"`
+	TenantID     string    `json:"tenant_id"`
+	SizeBytes    int64     `json:"size_bytes"`
+	SHA256       string    `json:"sha256"`
+	CreatedAt    time.Time `json:"created_at"`
+	SourceOffset string    `json:"source_offset"`
+}
+
+// ListSnapshotsResponse represents a page of snapshot manifests
+type ListSnapshotsResponse struct {
+	Snapshots     []*SnapshotResponse `json:"snapshots"`
+	NextPageToken string              `json:"next_page_token,omitempty"`
+}
@@ -10,6 +10,20 @@ type CreateTenantRequest struct {
 	Metadata    map[string]interface{} `json:"metadata"`
 }
 
+// RestoreTenantRequest represents a request to restore a soft-deleted tenant
+type RestoreTenantRequest struct {
+	ID string `json:"id" binding:"required"`
+
+	// RequestedByTenantID and RequestedByIsAdmin carry the authenticated
+	// principal's tenant scope, set by the handler from the verified
+	// bearer token rather than from anything in the request body.
+	// RestoreTenantUseCase uses them to reject cross-tenant restores.
+	// Both are zero when no Authenticate middleware is wired in front of
+	// the handler, in which case the use case does not enforce scoping.
+	RequestedByTenantID string
+	RequestedByIsAdmin  bool
+}
+
 // UpdateTenantRequest represents a request to update a tenant
 type UpdateTenantRequest struct {
 	Name        string                 `json:"name"`
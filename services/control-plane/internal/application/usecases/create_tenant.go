@@ -29,7 +29,7 @@ func (uc *CreateTenantUseCase) Execute(req dto.CreateTenantRequest) (*dto.Tenant
 	// Check if tenant already exists
 	exists, err := uc.tenantRepo.Exists(req.ID)
 	if err != nil {
-		return nil, err
+		return nil, domain.Wrap(err, domain.CodeInternal, "failed to check for existing tenant")
 	}
 	if exists {
 		return nil, domain.ErrTenantAlreadyExists
@@ -38,7 +38,7 @@ func (uc *CreateTenantUseCase) Execute(req dto.CreateTenantRequest) (*dto.Tenant
 	// Create domain entity
 	tenant, err := entities.NewTenant(req.ID, req.Name, req.Description)
 	if err != nil {
-		return nil, err
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid tenant")
 	}
 
 	// Add metadata
@@ -48,7 +48,7 @@ func (uc *CreateTenantUseCase) Execute(req dto.CreateTenantRequest) (*dto.Tenant
 
 	// Persist tenant
 	if err := uc.tenantRepo.Save(tenant); err != nil {
-		return nil, err
+		return nil, domain.Wrap(err, domain.CodeInternal, "failed to save tenant")
 	}
 
 	// Log audit event
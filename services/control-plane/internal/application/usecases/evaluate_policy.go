@@ -1,10 +1,11 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/allsource/control-plane/internal/application/dto"
 	"github.com/allsource/control-plane/internal/domain/entities"
 	"github.com/allsource/control-plane/internal/domain/repositories"
-	"sort"
 )
 
 // EvaluatePolicyUseCase handles policy evaluation
@@ -19,56 +20,37 @@ func NewEvaluatePolicyUseCase(policyRepo repositories.PolicyRepository) *Evaluat
 	}
 }
 
-// Execute evaluates policies for a given resource and attributes
+// Execute evaluates policies for a given resource and attributes, using a
+// PolicySet so deny always wins regardless of priority order.
 func (uc *EvaluatePolicyUseCase) Execute(req dto.EvaluatePolicyRequest) (*dto.EvaluatePolicyResponse, error) {
-	// Get all enabled policies for the resource
 	policies, err := uc.policyRepo.FindByResource(req.Resource)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter to only enabled policies
-	enabledPolicies := make([]*entities.Policy, 0)
-	for _, p := range policies {
-		if p.Enabled {
-			enabledPolicies = append(enabledPolicies, p)
-		}
-	}
-
-	// Sort by priority (higher first)
-	sort.Slice(enabledPolicies, func(i, j int) bool {
-		return enabledPolicies[i].Priority > enabledPolicies[j].Priority
-	})
-
-	// Evaluate policies in priority order
-	var reasons []string
-	for _, policy := range enabledPolicies {
-		matches, err := policy.Evaluate(req.Attributes)
-		if err != nil {
-			continue
-		}
-
-		if matches {
-			// Policy matched - return decision
-			allowed := policy.Action == entities.ActionAllow
-
-			if policy.Action == entities.ActionWarn {
-				reasons = append(reasons, "Policy matched (warn): "+policy.Name)
-				continue // Continue evaluating
-			}
+	algorithm := entities.CombiningAlgorithm(req.CombiningAlgorithm)
+	decision := entities.NewPolicySet(policies).Decide(context.Background(), req.Attributes, algorithm)
 
-			return &dto.EvaluatePolicyResponse{
-				Allowed:   allowed,
-				MatchedID: policy.ID,
-				Action:    string(policy.Action),
-				Reasons:   append(reasons, "Policy matched: "+policy.Name),
-			}, nil
-		}
-	}
-
-	// No policies matched - default allow
 	return &dto.EvaluatePolicyResponse{
-		Allowed: true,
-		Reasons: append(reasons, "No matching policies, default allow"),
+		Allowed:     decision.Effect != entities.ActionDeny,
+		MatchedID:   decision.MatchedPolicyID,
+		Action:      string(decision.Effect),
+		Reasons:     decision.Reasons,
+		Obligations: toObligationDTOs(decision.Obligations),
+		Advice:      toObligationDTOs(decision.Advice),
 	}, nil
 }
+
+// toObligationDTOs converts a Decision's Obligations or Advice to their DTO
+// form; nil in, nil out, so the JSON response omits an empty key instead
+// of emitting "[]".
+func toObligationDTOs(obligations []entities.PolicyObligation) []dto.PolicyObligationDTO {
+	if obligations == nil {
+		return nil
+	}
+	out := make([]dto.PolicyObligationDTO, len(obligations))
+	for i, o := range obligations {
+		out[i] = dto.PolicyObligationDTO{PolicyID: o.PolicyID, Values: o.Values}
+	}
+	return out
+}
@@ -0,0 +1,283 @@
+package usecases
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// secretIDBytes is the amount of random data read to produce a plaintext
+// SecretID, matching the entropy of a uuid.NewString() pair.
+const secretIDBytes = 32
+
+// secretIDLockShards is the number of mutexes secretIDLocks spreads
+// SecretID IDs across, so logins against different SecretIDs don't
+// contend on a single lock while a login against the same SecretID is
+// still serialized (needed so a concurrent replay can't both pass the
+// remaining-uses check before either decrements it).
+const secretIDLockShards = 32
+
+// secretIDLocks is a small sharded lock map guarding the read-check-decrement
+// sequence in LoginWithAppRoleUseCase.Execute. The repository's own mutex
+// only protects individual map accesses, not this multi-step sequence, so
+// two concurrent logins presenting the same last-use SecretID could
+// otherwise both pass the remaining-uses check before either decrements
+// it. The zero value is ready to use.
+type secretIDLocks struct {
+	shards [secretIDLockShards]sync.Mutex
+}
+
+func newSecretIDLocks() *secretIDLocks {
+	return &secretIDLocks{}
+}
+
+func (l *secretIDLocks) lock(secretID string) {
+	l.shards[shardIndex(secretID)].Lock()
+}
+
+func (l *secretIDLocks) unlock(secretID string) {
+	l.shards[shardIndex(secretID)].Unlock()
+}
+
+func shardIndex(key string) int {
+	h := sha256.Sum256([]byte(key))
+	return int(h[0]) % secretIDLockShards
+}
+
+// HashSecretIDValue computes the HMAC-SHA256 of a plaintext SecretID under
+// key, the form persisted as SecretID.HashedValue so a compromised
+// datastore never exposes a directly usable credential.
+func HashSecretIDValue(key []byte, plaintext string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(plaintext))
+	return mac.Sum(nil)
+}
+
+// appRoleToResponse maps a domain AppRole to its DTO representation.
+func appRoleToResponse(role *entities.AppRole) *dto.AppRoleResponse {
+	return &dto.AppRoleResponse{
+		ID:              role.ID,
+		RoleID:          role.RoleID,
+		TenantID:        role.TenantID,
+		BoundPolicies:   role.BoundPolicies,
+		SecretIDTTL:     role.SecretIDTTL.String(),
+		SecretIDNumUses: role.SecretIDNumUses,
+		TokenTTL:        role.TokenTTL.String(),
+		BoundCIDRs:      role.BoundCIDRs,
+		CreatedAt:       role.CreatedAt,
+		UpdatedAt:       role.UpdatedAt,
+	}
+}
+
+// CreateAppRoleUseCase creates a new AppRole.
+type CreateAppRoleUseCase struct {
+	roleRepo repositories.AppRoleRepository
+}
+
+// NewCreateAppRoleUseCase creates a new CreateAppRoleUseCase.
+func NewCreateAppRoleUseCase(roleRepo repositories.AppRoleRepository) *CreateAppRoleUseCase {
+	return &CreateAppRoleUseCase{roleRepo: roleRepo}
+}
+
+// Execute validates and persists req as a new AppRole.
+func (uc *CreateAppRoleUseCase) Execute(req dto.CreateAppRoleRequest) (*dto.AppRoleResponse, error) {
+	secretIDTTL, err := parseDurationOrZero(req.SecretIDTTL)
+	if err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid secret_id_ttl")
+	}
+	tokenTTL, err := parseDurationOrZero(req.TokenTTL)
+	if err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid token_ttl")
+	}
+
+	role, err := entities.NewAppRole(req.TenantID, req.BoundPolicies, secretIDTTL, tokenTTL, req.SecretIDNumUses, req.BoundCIDRs)
+	if err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid approle")
+	}
+
+	if err := uc.roleRepo.SaveAppRole(role); err != nil {
+		return nil, err
+	}
+
+	return appRoleToResponse(role), nil
+}
+
+// RotateRoleIDUseCase rotates an AppRole's public RoleID, invalidating the
+// previous one.
+type RotateRoleIDUseCase struct {
+	roleRepo repositories.AppRoleRepository
+}
+
+// NewRotateRoleIDUseCase creates a new RotateRoleIDUseCase.
+func NewRotateRoleIDUseCase(roleRepo repositories.AppRoleRepository) *RotateRoleIDUseCase {
+	return &RotateRoleIDUseCase{roleRepo: roleRepo}
+}
+
+// Execute rotates the RoleID of the AppRole identified by id.
+func (uc *RotateRoleIDUseCase) Execute(id string) (*dto.AppRoleResponse, error) {
+	role, err := uc.roleRepo.FindAppRoleByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	role.RotateRoleID()
+
+	if err := uc.roleRepo.UpdateAppRole(role); err != nil {
+		return nil, err
+	}
+
+	return appRoleToResponse(role), nil
+}
+
+// GenerateSecretIDUseCase issues a new SecretID under an existing AppRole.
+type GenerateSecretIDUseCase struct {
+	roleRepo repositories.AppRoleRepository
+	hmacKey  []byte
+}
+
+// NewGenerateSecretIDUseCase creates a new GenerateSecretIDUseCase, hashing
+// every SecretID it generates with hmacKey.
+func NewGenerateSecretIDUseCase(roleRepo repositories.AppRoleRepository, hmacKey []byte) *GenerateSecretIDUseCase {
+	return &GenerateSecretIDUseCase{roleRepo: roleRepo, hmacKey: hmacKey}
+}
+
+// Execute generates a random plaintext SecretID for the AppRole identified
+// by appRoleID, applying req's overrides (or the AppRole's own defaults,
+// when a field is left zero), and returns it. The plaintext is returned
+// only this once; only its HMAC is persisted.
+func (uc *GenerateSecretIDUseCase) Execute(appRoleID string, req dto.GenerateSecretIDRequest) (*dto.SecretIDResponse, error) {
+	role, err := uc.roleRepo.FindAppRoleByID(appRoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := parseDurationOrZero(req.TTL)
+	if err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid ttl")
+	}
+	if ttl == 0 {
+		ttl = role.SecretIDTTL
+	}
+	numUses := req.NumUses
+	if numUses == 0 {
+		numUses = role.SecretIDNumUses
+	}
+
+	plaintext, err := randomSecretID()
+	if err != nil {
+		return nil, domain.Wrap(err, domain.CodeInternal, "generating secret_id")
+	}
+
+	secretID := entities.NewSecretID(role.ID, HashSecretIDValue(uc.hmacKey, plaintext), ttl, numUses, req.BoundCIDRs, req.Metadata)
+
+	if err := uc.roleRepo.SaveSecretID(secretID); err != nil {
+		return nil, err
+	}
+
+	resp := &dto.SecretIDResponse{
+		ID:            secretID.ID,
+		SecretID:      plaintext,
+		AppRoleID:     secretID.AppRoleID,
+		RemainingUses: secretID.RemainingUses,
+		CreatedAt:     secretID.CreatedAt,
+	}
+	if !secretID.ExpiresAt.IsZero() {
+		resp.ExpiresAt = &secretID.ExpiresAt
+	}
+	return resp, nil
+}
+
+// randomSecretID generates a hex-encoded random plaintext SecretID value.
+func randomSecretID() (string, error) {
+	buf := make([]byte, secretIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// LoginWithAppRoleUseCase authenticates a (RoleID, SecretID) pair. It
+// performs every check a login requires — existence, hash match,
+// expiry, use-count, and CIDR binding — and decrements the matched
+// SecretID's remaining uses, but stops short of minting a token: that
+// requires the signing key the root package holds, not the domain layer.
+type LoginWithAppRoleUseCase struct {
+	roleRepo repositories.AppRoleRepository
+	hmacKey  []byte
+	locks    *secretIDLocks
+}
+
+// NewLoginWithAppRoleUseCase creates a new LoginWithAppRoleUseCase,
+// verifying presented secret_id values against hmacKey.
+func NewLoginWithAppRoleUseCase(roleRepo repositories.AppRoleRepository, hmacKey []byte) *LoginWithAppRoleUseCase {
+	return &LoginWithAppRoleUseCase{roleRepo: roleRepo, hmacKey: hmacKey, locks: newSecretIDLocks()}
+}
+
+// Execute authenticates req, optionally enforcing sourceIP against both
+// the AppRole's and the matched SecretID's bound CIDRs (sourceIP == nil
+// skips that check, e.g. when the caller's address isn't known).
+func (uc *LoginWithAppRoleUseCase) Execute(req dto.AppRoleLoginRequest, sourceIP net.IP) (*dto.AppRoleLoginResult, error) {
+	role, err := uc.roleRepo.FindAppRoleByRoleID(req.RoleID)
+	if err != nil {
+		return nil, domain.ErrSecretIDInvalid
+	}
+	if sourceIP != nil && !role.AllowsSourceIP(sourceIP) {
+		return nil, domain.ErrSecretIDInvalid
+	}
+
+	candidates, err := uc.roleRepo.FindSecretIDsByAppRole(role.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	presented := HashSecretIDValue(uc.hmacKey, req.SecretID)
+	now := time.Now()
+
+	for _, secretID := range candidates {
+		if !hmac.Equal(presented, secretID.HashedValue) {
+			continue
+		}
+
+		uc.locks.lock(secretID.ID)
+		defer uc.locks.unlock(secretID.ID)
+
+		if secretID.IsExpired(now) || secretID.IsExhausted() {
+			return nil, domain.ErrSecretIDInvalid
+		}
+		if sourceIP != nil && !secretID.AllowsSourceIP(sourceIP) {
+			return nil, domain.ErrSecretIDInvalid
+		}
+
+		secretID.DecrementUse()
+		if err := uc.roleRepo.UpdateSecretID(secretID); err != nil {
+			return nil, err
+		}
+
+		return &dto.AppRoleLoginResult{
+			RoleID:        role.RoleID,
+			TenantID:      role.TenantID,
+			BoundPolicies: role.BoundPolicies,
+			TokenTTL:      role.TokenTTL,
+		}, nil
+	}
+
+	return nil, domain.ErrSecretIDInvalid
+}
+
+// parseDurationOrZero parses s as a Go duration, returning 0 (not an
+// error) for an empty string.
+func parseDurationOrZero(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(s)
+}
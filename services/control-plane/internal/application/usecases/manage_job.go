@@ -0,0 +1,178 @@
+package usecases
+
+import (
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// DefaultJobTriggeredBy is the actor recorded on a job whose
+// CreateJobRequest didn't specify one.
+const DefaultJobTriggeredBy = "api"
+
+// JobEnqueuer is the subset of JobDispatcher's API the job use cases need,
+// kept narrow so they don't depend on the workers package.
+type JobEnqueuer interface {
+	Enqueue(job *entities.Job)
+	Cancel(job *entities.Job) error
+}
+
+// jobToResponse maps a domain Job to its DTO representation.
+func jobToResponse(job *entities.Job) *dto.JobResponse {
+	return &dto.JobResponse{
+		ID:           job.ID,
+		Type:         string(job.Type),
+		Status:       string(job.Status),
+		Options:      job.Options,
+		CreationTime: job.CreationTime,
+		UpdateTime:   job.UpdateTime,
+		StartTime:    job.StartTime,
+		CronStr:      job.CronStr,
+		TriggeredBy:  job.TriggeredBy,
+		Error:        job.Error,
+	}
+}
+
+// jobExecutionToResponse maps a domain JobExecution to its DTO
+// representation.
+func jobExecutionToResponse(execution *entities.JobExecution) *dto.JobExecutionResponse {
+	return &dto.JobExecutionResponse{
+		ID:           execution.ID,
+		JobID:        execution.JobID,
+		Status:       string(execution.Status),
+		Stdout:       execution.Stdout,
+		Stderr:       execution.Stderr,
+		ExitCode:     execution.ExitCode,
+		TraceID:      execution.TraceID,
+		CreationTime: execution.CreationTime,
+		UpdateTime:   execution.UpdateTime,
+		StartTime:    execution.StartTime,
+		EndTime:      execution.EndTime,
+	}
+}
+
+// CreateJobUseCase persists and enqueues a new asynchronous job.
+type CreateJobUseCase struct {
+	jobRepo    repositories.JobRepository
+	dispatcher JobEnqueuer
+}
+
+// NewCreateJobUseCase creates a new CreateJobUseCase.
+func NewCreateJobUseCase(jobRepo repositories.JobRepository, dispatcher JobEnqueuer) *CreateJobUseCase {
+	return &CreateJobUseCase{jobRepo: jobRepo, dispatcher: dispatcher}
+}
+
+// Execute validates and persists req as a pending Job, then hands it to
+// the dispatcher for asynchronous execution.
+func (uc *CreateJobUseCase) Execute(req dto.CreateJobRequest) (*dto.JobResponse, error) {
+	triggeredBy := req.TriggeredBy
+	if triggeredBy == "" {
+		triggeredBy = DefaultJobTriggeredBy
+	}
+
+	job, err := entities.NewJob(entities.JobType(req.Type), req.Options, req.CronStr, triggeredBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.jobRepo.Save(job); err != nil {
+		return nil, err
+	}
+
+	uc.dispatcher.Enqueue(job)
+
+	return jobToResponse(job), nil
+}
+
+// GetJobUseCase retrieves a single job by ID.
+type GetJobUseCase struct {
+	jobRepo repositories.JobRepository
+}
+
+// NewGetJobUseCase creates a new GetJobUseCase.
+func NewGetJobUseCase(jobRepo repositories.JobRepository) *GetJobUseCase {
+	return &GetJobUseCase{jobRepo: jobRepo}
+}
+
+// Execute retrieves the job with the given id.
+func (uc *GetJobUseCase) Execute(id string) (*dto.JobResponse, error) {
+	job, err := uc.jobRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return jobToResponse(job), nil
+}
+
+// ListJobsUseCase retrieves every job.
+type ListJobsUseCase struct {
+	jobRepo repositories.JobRepository
+}
+
+// NewListJobsUseCase creates a new ListJobsUseCase.
+func NewListJobsUseCase(jobRepo repositories.JobRepository) *ListJobsUseCase {
+	return &ListJobsUseCase{jobRepo: jobRepo}
+}
+
+// Execute retrieves every job known to the repository.
+func (uc *ListJobsUseCase) Execute() ([]*dto.JobResponse, error) {
+	jobs, err := uc.jobRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.JobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, jobToResponse(job))
+	}
+	return responses, nil
+}
+
+// CancelJobUseCase cancels a pending or running job.
+type CancelJobUseCase struct {
+	jobRepo    repositories.JobRepository
+	dispatcher JobEnqueuer
+}
+
+// NewCancelJobUseCase creates a new CancelJobUseCase.
+func NewCancelJobUseCase(jobRepo repositories.JobRepository, dispatcher JobEnqueuer) *CancelJobUseCase {
+	return &CancelJobUseCase{jobRepo: jobRepo, dispatcher: dispatcher}
+}
+
+// Execute cancels the job with the given id.
+func (uc *CancelJobUseCase) Execute(id string) (*dto.JobResponse, error) {
+	job, err := uc.jobRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.dispatcher.Cancel(job); err != nil {
+		return nil, err
+	}
+
+	return jobToResponse(job), nil
+}
+
+// ListJobExecutionsUseCase retrieves every execution of a single job, most
+// recently created first.
+type ListJobExecutionsUseCase struct {
+	executionRepo repositories.JobExecutionRepository
+}
+
+// NewListJobExecutionsUseCase creates a new ListJobExecutionsUseCase.
+func NewListJobExecutionsUseCase(executionRepo repositories.JobExecutionRepository) *ListJobExecutionsUseCase {
+	return &ListJobExecutionsUseCase{executionRepo: executionRepo}
+}
+
+// Execute retrieves every execution of the job with the given id.
+func (uc *ListJobExecutionsUseCase) Execute(jobID string) ([]*dto.JobExecutionResponse, error) {
+	executions, err := uc.executionRepo.FindByJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.JobExecutionResponse, 0, len(executions))
+	for _, execution := range executions {
+		responses = append(responses, jobExecutionToResponse(execution))
+	}
+	return responses, nil
+}
@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/infrastructure/persistence"
+	"github.com/allsource/control-plane/internal/infrastructure/workers"
+)
+
+func TestCreateJobUseCase_Execute(t *testing.T) {
+	jobRepo := persistence.NewMemoryJobRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	dispatcher := workers.NewJobDispatcher(jobRepo, auditRepo, persistence.NewMemoryJobExecutionRepository(), 1)
+	useCase := NewCreateJobUseCase(jobRepo, dispatcher)
+
+	resp, err := useCase.Execute(dto.CreateJobRequest{Type: "snapshot"})
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if resp.Status != "pending" {
+		t.Errorf("Status = %v, want pending", resp.Status)
+	}
+	if resp.TriggeredBy != DefaultJobTriggeredBy {
+		t.Errorf("TriggeredBy = %v, want %v", resp.TriggeredBy, DefaultJobTriggeredBy)
+	}
+
+	if _, err := jobRepo.FindByID(resp.ID); err != nil {
+		t.Errorf("job should have been persisted: %v", err)
+	}
+}
+
+func TestCreateJobUseCase_InvalidType(t *testing.T) {
+	jobRepo := persistence.NewMemoryJobRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	dispatcher := workers.NewJobDispatcher(jobRepo, auditRepo, persistence.NewMemoryJobExecutionRepository(), 1)
+	useCase := NewCreateJobUseCase(jobRepo, dispatcher)
+
+	if _, err := useCase.Execute(dto.CreateJobRequest{Type: "bogus"}); err == nil {
+		t.Error("Execute() with an invalid job type should fail")
+	}
+}
+
+func TestGetJobUseCase_Execute(t *testing.T) {
+	jobRepo := persistence.NewMemoryJobRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	dispatcher := workers.NewJobDispatcher(jobRepo, auditRepo, persistence.NewMemoryJobExecutionRepository(), 1)
+	createUC := NewCreateJobUseCase(jobRepo, dispatcher)
+	getUC := NewGetJobUseCase(jobRepo)
+
+	created, _ := createUC.Execute(dto.CreateJobRequest{Type: "replay"})
+
+	resp, err := getUC.Execute(created.ID)
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if resp.ID != created.ID {
+		t.Errorf("ID = %v, want %v", resp.ID, created.ID)
+	}
+
+	if _, err := getUC.Execute("missing"); err != domain.ErrJobNotFound {
+		t.Errorf("Execute() on missing job error = %v, want ErrJobNotFound", err)
+	}
+}
+
+func TestListJobsUseCase_Execute(t *testing.T) {
+	jobRepo := persistence.NewMemoryJobRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	dispatcher := workers.NewJobDispatcher(jobRepo, auditRepo, persistence.NewMemoryJobExecutionRepository(), 1)
+	createUC := NewCreateJobUseCase(jobRepo, dispatcher)
+	listUC := NewListJobsUseCase(jobRepo)
+
+	_, _ = createUC.Execute(dto.CreateJobRequest{Type: "snapshot"})
+	_, _ = createUC.Execute(dto.CreateJobRequest{Type: "replay"})
+
+	resp, err := listUC.Execute()
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if len(resp) != 2 {
+		t.Errorf("len(resp) = %v, want 2", len(resp))
+	}
+}
+
+func TestCancelJobUseCase_Execute(t *testing.T) {
+	jobRepo := persistence.NewMemoryJobRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	dispatcher := workers.NewJobDispatcher(jobRepo, auditRepo, persistence.NewMemoryJobExecutionRepository(), 1)
+	createUC := NewCreateJobUseCase(jobRepo, dispatcher)
+	cancelUC := NewCancelJobUseCase(jobRepo, dispatcher)
+
+	created, _ := createUC.Execute(dto.CreateJobRequest{Type: "snapshot"})
+
+	resp, err := cancelUC.Execute(created.ID)
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if resp.Status != "canceled" {
+		t.Errorf("Status = %v, want canceled", resp.Status)
+	}
+
+	if _, err := cancelUC.Execute("missing"); err != domain.ErrJobNotFound {
+		t.Errorf("Execute() on missing job error = %v, want ErrJobNotFound", err)
+	}
+}
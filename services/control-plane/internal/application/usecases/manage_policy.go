@@ -0,0 +1,483 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// reloadPolicySet refreshes policySet from policyRepo, if policySet is
+// non-nil. It is best-effort: a reload failure leaves the live PolicySet
+// serving the last-known-good policies rather than failing the request
+// that triggered it.
+func reloadPolicySet(policyRepo repositories.PolicyRepository, policySet *entities.PolicySet) {
+	if policySet == nil {
+		return
+	}
+	policies, err := policyRepo.FindAll()
+	if err != nil {
+		return
+	}
+	policySet.Reload(policies)
+}
+
+// policyToResponse maps a domain Policy to its DTO representation.
+func policyToResponse(policy *entities.Policy) *dto.PolicyResponse {
+	conditions := make([]dto.PolicyConditionDTO, len(policy.Conditions))
+	for i, c := range policy.Conditions {
+		conditions[i] = dto.PolicyConditionDTO{
+			Field:    c.Field,
+			Operator: c.Operator,
+			Value:    c.Value,
+		}
+	}
+
+	return &dto.PolicyResponse{
+		ID:                 policy.ID,
+		Name:               policy.Name,
+		Description:        policy.Description,
+		Resource:           policy.Resource,
+		Action:             string(policy.Action),
+		Conditions:         conditions,
+		Priority:           policy.Priority,
+		Enabled:            policy.Enabled,
+		Expression:         policy.Expression,
+		ExpressionLanguage: string(policy.ExpressionLanguage),
+		Obligations:        policy.Obligations,
+		Advice:             policy.Advice,
+		Engine:             string(policy.Engine),
+		ModuleSource:       policy.ModuleSource,
+	}
+}
+
+// CreatePolicyUseCase handles policy creation.
+type CreatePolicyUseCase struct {
+	policyRepo repositories.PolicyRepository
+	auditRepo  repositories.AuditRepository
+	policySet  *entities.PolicySet
+}
+
+// NewCreatePolicyUseCase creates a new CreatePolicyUseCase. policySet may be
+// nil, in which case newly created policies only take effect for callers
+// that re-read policyRepo (e.g. EvaluatePolicyUseCase).
+func NewCreatePolicyUseCase(
+	policyRepo repositories.PolicyRepository,
+	auditRepo repositories.AuditRepository,
+	policySet *entities.PolicySet,
+) *CreatePolicyUseCase {
+	return &CreatePolicyUseCase{
+		policyRepo: policyRepo,
+		auditRepo:  auditRepo,
+		policySet:  policySet,
+	}
+}
+
+// Execute creates a new policy and hot-reloads the live PolicySet.
+func (uc *CreatePolicyUseCase) Execute(req dto.CreatePolicyRequest) (*dto.PolicyResponse, error) {
+	exists, err := uc.policyRepo.Exists(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domain.ErrPolicyAlreadyExists
+	}
+
+	policy, err := entities.NewPolicy(req.ID, req.Name, req.Description, req.Resource, entities.PolicyAction(req.Action), req.Priority)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range req.Conditions {
+		if err := policy.AddCondition(c.Field, c.Operator, c.Value); err != nil {
+			return nil, err
+		}
+	}
+	policy.Expression = req.Expression
+	if req.ExpressionLanguage != "" {
+		policy.ExpressionLanguage = entities.ExpressionLanguage(req.ExpressionLanguage)
+	}
+	policy.Obligations = req.Obligations
+	policy.Advice = req.Advice
+	if req.Engine != "" {
+		policy.Engine = entities.PolicyEngine(req.Engine)
+	}
+	policy.ModuleSource = req.ModuleSource
+
+	if err := entities.CompilePolicyModule(policy); err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "policy module failed to compile")
+	}
+
+	if err := uc.policyRepo.Save(policy); err != nil {
+		return nil, err
+	}
+	reloadPolicySet(uc.policyRepo, uc.policySet)
+
+	auditEvent, _ := entities.NewAuditEvent("policy.created", "create", "POST", "/policies")
+	auditEvent.WithResource("policy", policy.ID)
+	_ = uc.auditRepo.Log(auditEvent)
+
+	return policyToResponse(policy), nil
+}
+
+// UpdatePolicyUseCase handles policy updates.
+type UpdatePolicyUseCase struct {
+	policyRepo repositories.PolicyRepository
+	auditRepo  repositories.AuditRepository
+	policySet  *entities.PolicySet
+}
+
+// NewUpdatePolicyUseCase creates a new UpdatePolicyUseCase.
+func NewUpdatePolicyUseCase(
+	policyRepo repositories.PolicyRepository,
+	auditRepo repositories.AuditRepository,
+	policySet *entities.PolicySet,
+) *UpdatePolicyUseCase {
+	return &UpdatePolicyUseCase{
+		policyRepo: policyRepo,
+		auditRepo:  auditRepo,
+		policySet:  policySet,
+	}
+}
+
+// Execute updates an existing policy and hot-reloads the live PolicySet.
+func (uc *UpdatePolicyUseCase) Execute(id string, req dto.UpdatePolicyRequest) (*dto.PolicyResponse, error) {
+	policy, err := uc.policyRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		policy.Name = req.Name
+	}
+	policy.Description = req.Description
+	if req.Conditions != nil {
+		conditions := make([]entities.PolicyCondition, len(req.Conditions))
+		for i, c := range req.Conditions {
+			conditions[i] = entities.PolicyCondition{Field: c.Field, Operator: c.Operator, Value: c.Value}
+		}
+		policy.Conditions = conditions
+	}
+	if req.Priority != 0 {
+		policy.Priority = req.Priority
+	}
+	if req.Expression != "" {
+		policy.Expression = req.Expression
+	}
+	if req.ExpressionLanguage != "" {
+		policy.ExpressionLanguage = entities.ExpressionLanguage(req.ExpressionLanguage)
+	}
+	if req.Obligations != nil {
+		policy.Obligations = req.Obligations
+	}
+	if req.Advice != nil {
+		policy.Advice = req.Advice
+	}
+	if req.Engine != "" {
+		policy.Engine = entities.PolicyEngine(req.Engine)
+	}
+	if req.ModuleSource != "" {
+		policy.ModuleSource = req.ModuleSource
+	}
+	if req.Enabled != nil {
+		if *req.Enabled {
+			policy.Enable()
+		} else {
+			policy.Disable()
+		}
+	}
+
+	// Bump ResourceVersion so the compiled-expression and Rego caches,
+	// keyed by ID and ResourceVersion, compile the edited definition.
+	policy.ResourceVersion++
+
+	if err := entities.CompilePolicyModule(policy); err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "policy module failed to compile")
+	}
+
+	if err := uc.policyRepo.Update(policy); err != nil {
+		return nil, err
+	}
+	reloadPolicySet(uc.policyRepo, uc.policySet)
+
+	auditEvent, _ := entities.NewAuditEvent("policy.updated", "update", "PUT", "/policies/"+id)
+	auditEvent.WithResource("policy", policy.ID)
+	_ = uc.auditRepo.Log(auditEvent)
+
+	return policyToResponse(policy), nil
+}
+
+// DeletePolicyUseCase handles policy deletion.
+type DeletePolicyUseCase struct {
+	policyRepo repositories.PolicyRepository
+	auditRepo  repositories.AuditRepository
+	policySet  *entities.PolicySet
+}
+
+// NewDeletePolicyUseCase creates a new DeletePolicyUseCase.
+func NewDeletePolicyUseCase(
+	policyRepo repositories.PolicyRepository,
+	auditRepo repositories.AuditRepository,
+	policySet *entities.PolicySet,
+) *DeletePolicyUseCase {
+	return &DeletePolicyUseCase{
+		policyRepo: policyRepo,
+		auditRepo:  auditRepo,
+		policySet:  policySet,
+	}
+}
+
+// Execute deletes a policy and hot-reloads the live PolicySet.
+func (uc *DeletePolicyUseCase) Execute(id string) error {
+	exists, err := uc.policyRepo.Exists(id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrPolicyNotFound
+	}
+
+	if err := uc.policyRepo.Delete(id); err != nil {
+		return err
+	}
+	reloadPolicySet(uc.policyRepo, uc.policySet)
+
+	auditEvent, _ := entities.NewAuditEvent("policy.deleted", "delete", "DELETE", "/policies/"+id)
+	auditEvent.WithResource("policy", id)
+	_ = uc.auditRepo.Log(auditEvent)
+
+	return nil
+}
+
+// SetPolicyEnabledUseCase enables or disables an existing policy without
+// touching any of its other fields, and hot-reloads the live PolicySet.
+type SetPolicyEnabledUseCase struct {
+	policyRepo repositories.PolicyRepository
+	auditRepo  repositories.AuditRepository
+	policySet  *entities.PolicySet
+}
+
+// NewSetPolicyEnabledUseCase creates a new SetPolicyEnabledUseCase.
+func NewSetPolicyEnabledUseCase(
+	policyRepo repositories.PolicyRepository,
+	auditRepo repositories.AuditRepository,
+	policySet *entities.PolicySet,
+) *SetPolicyEnabledUseCase {
+	return &SetPolicyEnabledUseCase{
+		policyRepo: policyRepo,
+		auditRepo:  auditRepo,
+		policySet:  policySet,
+	}
+}
+
+// Execute sets policy id's Enabled flag to enabled.
+func (uc *SetPolicyEnabledUseCase) Execute(id string, enabled bool) (*dto.PolicyResponse, error) {
+	policy, err := uc.policyRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType, action := "policy.enabled", "enable"
+	if enabled {
+		policy.Enable()
+	} else {
+		policy.Disable()
+		eventType, action = "policy.disabled", "disable"
+	}
+
+	if err := uc.policyRepo.Update(policy); err != nil {
+		return nil, err
+	}
+	reloadPolicySet(uc.policyRepo, uc.policySet)
+
+	auditEvent, _ := entities.NewAuditEvent(eventType, action, "POST", "/policies/"+id+"/"+action)
+	auditEvent.WithResource("policy", policy.ID)
+	_ = uc.auditRepo.Log(auditEvent)
+
+	return policyToResponse(policy), nil
+}
+
+// GetPolicyBindingsUseCase lists the subjects (tenants/users) a policy
+// currently applies to.
+type GetPolicyBindingsUseCase struct {
+	policyRepo repositories.PolicyRepository
+}
+
+// NewGetPolicyBindingsUseCase creates a new GetPolicyBindingsUseCase.
+func NewGetPolicyBindingsUseCase(policyRepo repositories.PolicyRepository) *GetPolicyBindingsUseCase {
+	return &GetPolicyBindingsUseCase{policyRepo: policyRepo}
+}
+
+// Execute derives the bindings for policy id from its tenant_id/user_id
+// Conditions: an "eq" condition binds a single subject, an "in" condition
+// binds every value in its list.
+func (uc *GetPolicyBindingsUseCase) Execute(id string) (*dto.PolicyBindingsResponse, error) {
+	policy, err := uc.policyRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PolicyBindingsResponse{
+		PolicyID: policy.ID,
+		Bindings: policyBindings(policy),
+	}, nil
+}
+
+// UnbindPolicyUseCase removes a subject from a policy's bindings.
+type UnbindPolicyUseCase struct {
+	policyRepo repositories.PolicyRepository
+	auditRepo  repositories.AuditRepository
+	policySet  *entities.PolicySet
+}
+
+// NewUnbindPolicyUseCase creates a new UnbindPolicyUseCase.
+func NewUnbindPolicyUseCase(
+	policyRepo repositories.PolicyRepository,
+	auditRepo repositories.AuditRepository,
+	policySet *entities.PolicySet,
+) *UnbindPolicyUseCase {
+	return &UnbindPolicyUseCase{
+		policyRepo: policyRepo,
+		auditRepo:  auditRepo,
+		policySet:  policySet,
+	}
+}
+
+// Execute removes req.Value from whichever of policy id's Conditions bind
+// req.Field to it: the whole condition if it's an "eq" match, or just that
+// one value if it's an "in" list (the condition itself is dropped once its
+// list empties out).
+func (uc *UnbindPolicyUseCase) Execute(id string, req dto.UnbindPolicyRequest) (*dto.PolicyResponse, error) {
+	policy, err := uc.policyRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Conditions = unbindConditions(policy.Conditions, req.Field, req.Value)
+
+	if err := uc.policyRepo.Update(policy); err != nil {
+		return nil, err
+	}
+	reloadPolicySet(uc.policyRepo, uc.policySet)
+
+	auditEvent, _ := entities.NewAuditEvent("policy.unbound", "unbind", "POST", "/policies/"+id+"/unbind")
+	auditEvent.WithResource("policy", policy.ID)
+	_ = uc.auditRepo.Log(auditEvent)
+
+	return policyToResponse(policy), nil
+}
+
+// policyBindings scans policy's tenant_id/user_id Conditions and flattens
+// them into one PolicyBindingDTO per subject.
+func policyBindings(policy *entities.Policy) []dto.PolicyBindingDTO {
+	var bindings []dto.PolicyBindingDTO
+	for _, c := range policy.Conditions {
+		if c.Field != "tenant_id" && c.Field != "user_id" {
+			continue
+		}
+		for _, value := range conditionValues(c) {
+			bindings = append(bindings, dto.PolicyBindingDTO{Field: c.Field, Value: value})
+		}
+	}
+	return bindings
+}
+
+// conditionValues returns every subject value a single condition carries:
+// one for "eq", every element for "in".
+func conditionValues(c entities.PolicyCondition) []string {
+	switch c.Operator {
+	case "eq":
+		return []string{fmt.Sprintf("%v", c.Value)}
+	case "in":
+		switch arr := c.Value.(type) {
+		case []string:
+			return arr
+		case []interface{}:
+			values := make([]string, len(arr))
+			for i, v := range arr {
+				values[i] = fmt.Sprintf("%v", v)
+			}
+			return values
+		}
+	}
+	return nil
+}
+
+// unbindConditions removes value from whichever of conditions matches field,
+// dropping an "in" condition entirely once its list empties out.
+func unbindConditions(conditions []entities.PolicyCondition, field, value string) []entities.PolicyCondition {
+	kept := make([]entities.PolicyCondition, 0, len(conditions))
+	for _, c := range conditions {
+		if c.Field != field {
+			kept = append(kept, c)
+			continue
+		}
+
+		switch c.Operator {
+		case "eq":
+			if fmt.Sprintf("%v", c.Value) == value {
+				continue
+			}
+			kept = append(kept, c)
+		case "in":
+			remaining := []string{}
+			for _, v := range conditionValues(c) {
+				if v != value {
+					remaining = append(remaining, v)
+				}
+			}
+			if len(remaining) == 0 {
+				continue
+			}
+			c.Value = remaining
+			kept = append(kept, c)
+		default:
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// ListPoliciesUseCase returns every policy known to the repository.
+type ListPoliciesUseCase struct {
+	policyRepo repositories.PolicyRepository
+}
+
+// NewListPoliciesUseCase creates a new ListPoliciesUseCase.
+func NewListPoliciesUseCase(policyRepo repositories.PolicyRepository) *ListPoliciesUseCase {
+	return &ListPoliciesUseCase{policyRepo: policyRepo}
+}
+
+// Execute lists every policy.
+func (uc *ListPoliciesUseCase) Execute() ([]*dto.PolicyResponse, error) {
+	policies, err := uc.policyRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.PolicyResponse, len(policies))
+	for i, policy := range policies {
+		responses[i] = policyToResponse(policy)
+	}
+	return responses, nil
+}
+
+// GetPolicyUseCase fetches a single policy by ID.
+type GetPolicyUseCase struct {
+	policyRepo repositories.PolicyRepository
+}
+
+// NewGetPolicyUseCase creates a new GetPolicyUseCase.
+func NewGetPolicyUseCase(policyRepo repositories.PolicyRepository) *GetPolicyUseCase {
+	return &GetPolicyUseCase{policyRepo: policyRepo}
+}
+
+// Execute fetches a policy by ID.
+func (uc *GetPolicyUseCase) Execute(id string) (*dto.PolicyResponse, error) {
+	policy, err := uc.policyRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return policyToResponse(policy), nil
+}
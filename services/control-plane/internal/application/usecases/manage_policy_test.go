@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/infrastructure/persistence"
+)
+
+func TestCreatePolicyUseCase_Execute(t *testing.T) {
+	policyRepo := persistence.NewMemoryPolicyRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	policySet := entities.NewPolicySet(nil)
+	useCase := NewCreatePolicyUseCase(policyRepo, auditRepo, policySet)
+
+	req := dto.CreatePolicyRequest{
+		ID:       "policy-new",
+		Name:     "New Policy",
+		Resource: "tenant",
+		Action:   "deny",
+		Priority: 100,
+	}
+
+	resp, err := useCase.Execute(req)
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if resp.ID != "policy-new" {
+		t.Errorf("ID = %v, want policy-new", resp.ID)
+	}
+
+	if _, err := useCase.Execute(req); err != domain.ErrPolicyAlreadyExists {
+		t.Errorf("Execute() on duplicate ID error = %v, want ErrPolicyAlreadyExists", err)
+	}
+}
+
+func TestCreatePolicyUseCase_ReloadsPolicySet(t *testing.T) {
+	policyRepo := persistence.NewMemoryPolicyRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	policySet := entities.NewPolicySet(nil)
+	useCase := NewCreatePolicyUseCase(policyRepo, auditRepo, policySet)
+
+	req := dto.CreatePolicyRequest{
+		ID:       "policy-deny-all",
+		Name:     "Deny All",
+		Resource: "tenant",
+		Action:   "deny",
+		Priority: 100,
+	}
+	if _, err := useCase.Execute(req); err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+
+	decision := policySet.Decide(context.Background(), map[string]interface{}{}, entities.CombineDenyOverrides)
+	if decision.Effect != entities.ActionDeny {
+		t.Errorf("PolicySet should reflect newly created policy, got Effect = %v", decision.Effect)
+	}
+}
+
+func TestUpdatePolicyUseCase_Execute(t *testing.T) {
+	policyRepo := persistence.NewMemoryPolicyRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	policy, _ := entities.NewPolicy("policy-update", "Original", "", "tenant", entities.ActionAllow, 10)
+	_ = policyRepo.Save(policy)
+
+	useCase := NewUpdatePolicyUseCase(policyRepo, auditRepo, nil)
+
+	disabled := false
+	resp, err := useCase.Execute("policy-update", dto.UpdatePolicyRequest{
+		Name:    "Updated",
+		Enabled: &disabled,
+	})
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if resp.Name != "Updated" {
+		t.Errorf("Name = %v, want Updated", resp.Name)
+	}
+	if resp.Enabled {
+		t.Error("Enabled should be false after update")
+	}
+
+	if _, err := useCase.Execute("missing", dto.UpdatePolicyRequest{}); err != domain.ErrPolicyNotFound {
+		t.Errorf("Execute() on missing policy error = %v, want ErrPolicyNotFound", err)
+	}
+}
+
+func TestDeletePolicyUseCase_Execute(t *testing.T) {
+	policyRepo := persistence.NewMemoryPolicyRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	policy, _ := entities.NewPolicy("policy-delete", "To Delete", "", "tenant", entities.ActionAllow, 10)
+	_ = policyRepo.Save(policy)
+
+	useCase := NewDeletePolicyUseCase(policyRepo, auditRepo, nil)
+
+	if err := useCase.Execute("policy-delete"); err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+
+	if err := useCase.Execute("policy-delete"); err != domain.ErrPolicyNotFound {
+		t.Errorf("Execute() on already-deleted policy error = %v, want ErrPolicyNotFound", err)
+	}
+}
+
+func TestListPoliciesUseCase_Execute(t *testing.T) {
+	policyRepo := persistence.NewMemoryPolicyRepository()
+	before, _ := policyRepo.FindAll()
+
+	useCase := NewListPoliciesUseCase(policyRepo)
+	resp, err := useCase.Execute()
+	if err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if len(resp) != len(before) {
+		t.Errorf("len(resp) = %v, want %v", len(resp), len(before))
+	}
+}
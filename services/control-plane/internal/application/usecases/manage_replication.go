@@ -0,0 +1,225 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// DefaultReplicationTriggeredBy is the actor recorded on a replication
+// policy whose CreateReplicationPolicyRequest didn't specify one.
+const DefaultReplicationTriggeredBy = "api"
+
+// ReplicationTrigger is the subset of replication.Scheduler's API the
+// replication use cases need, kept narrow so they don't depend on the
+// infrastructure/replication package.
+type ReplicationTrigger interface {
+	TriggerNow(ctx context.Context, policy *entities.ReplicationPolicy) (*entities.ReplicationExecution, error)
+}
+
+func targetToResponse(target *entities.ReplicationTarget) *dto.ReplicationTargetResponse {
+	return &dto.ReplicationTargetResponse{
+		ID:           target.ID,
+		Name:         target.Name,
+		URL:          target.URL,
+		Enabled:      target.Enabled,
+		CreationTime: target.CreationTime,
+		UpdateTime:   target.UpdateTime,
+	}
+}
+
+func replicationPolicyToResponse(policy *entities.ReplicationPolicy) *dto.ReplicationPolicyResponse {
+	return &dto.ReplicationPolicyResponse{
+		ID:             policy.ID,
+		SourceResource: policy.SourceResource,
+		TargetID:       policy.TargetID,
+		CronStr:        policy.CronStr,
+		Priority:       policy.Priority,
+		TriggeredBy:    policy.TriggeredBy,
+		Enabled:        policy.Enabled,
+		CreationTime:   policy.CreationTime,
+		UpdateTime:     policy.UpdateTime,
+	}
+}
+
+func executionToResponse(execution *entities.ReplicationExecution) *dto.ReplicationExecutionResponse {
+	return &dto.ReplicationExecutionResponse{
+		ID:           execution.ID,
+		PolicyID:     execution.PolicyID,
+		TargetID:     execution.TargetID,
+		Status:       string(execution.Status),
+		Version:      execution.Version,
+		Attempt:      execution.Attempt,
+		Error:        execution.Error,
+		CreationTime: execution.CreationTime,
+		UpdateTime:   execution.UpdateTime,
+		StartTime:    execution.StartTime,
+		EndTime:      execution.EndTime,
+	}
+}
+
+// CreateReplicationTargetUseCase registers a new replication target.
+type CreateReplicationTargetUseCase struct {
+	targetRepo repositories.ReplicationTargetRepository
+}
+
+// NewCreateReplicationTargetUseCase creates a new
+// CreateReplicationTargetUseCase.
+func NewCreateReplicationTargetUseCase(targetRepo repositories.ReplicationTargetRepository) *CreateReplicationTargetUseCase {
+	return &CreateReplicationTargetUseCase{targetRepo: targetRepo}
+}
+
+// Execute validates and persists req as a new ReplicationTarget.
+func (uc *CreateReplicationTargetUseCase) Execute(req dto.CreateReplicationTargetRequest) (*dto.ReplicationTargetResponse, error) {
+	target, err := entities.NewReplicationTarget(req.Name, req.URL, req.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.targetRepo.Save(target); err != nil {
+		return nil, err
+	}
+
+	return targetToResponse(target), nil
+}
+
+// ListReplicationTargetsUseCase retrieves every replication target.
+type ListReplicationTargetsUseCase struct {
+	targetRepo repositories.ReplicationTargetRepository
+}
+
+// NewListReplicationTargetsUseCase creates a new
+// ListReplicationTargetsUseCase.
+func NewListReplicationTargetsUseCase(targetRepo repositories.ReplicationTargetRepository) *ListReplicationTargetsUseCase {
+	return &ListReplicationTargetsUseCase{targetRepo: targetRepo}
+}
+
+// Execute retrieves every replication target known to the repository.
+func (uc *ListReplicationTargetsUseCase) Execute() ([]*dto.ReplicationTargetResponse, error) {
+	targets, err := uc.targetRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ReplicationTargetResponse, 0, len(targets))
+	for _, target := range targets {
+		responses = append(responses, targetToResponse(target))
+	}
+	return responses, nil
+}
+
+// CreateReplicationPolicyUseCase schedules replication of this node's
+// policies to a target.
+type CreateReplicationPolicyUseCase struct {
+	policyRepo repositories.ReplicationPolicyRepository
+	targetRepo repositories.ReplicationTargetRepository
+}
+
+// NewCreateReplicationPolicyUseCase creates a new
+// CreateReplicationPolicyUseCase.
+func NewCreateReplicationPolicyUseCase(policyRepo repositories.ReplicationPolicyRepository, targetRepo repositories.ReplicationTargetRepository) *CreateReplicationPolicyUseCase {
+	return &CreateReplicationPolicyUseCase{policyRepo: policyRepo, targetRepo: targetRepo}
+}
+
+// Execute validates and persists req as a new ReplicationPolicy.
+func (uc *CreateReplicationPolicyUseCase) Execute(req dto.CreateReplicationPolicyRequest) (*dto.ReplicationPolicyResponse, error) {
+	if _, err := uc.targetRepo.FindByID(req.TargetID); err != nil {
+		return nil, err
+	}
+
+	triggeredBy := req.TriggeredBy
+	if triggeredBy == "" {
+		triggeredBy = DefaultReplicationTriggeredBy
+	}
+
+	policy, err := entities.NewReplicationPolicy(req.TargetID, req.CronStr, req.Priority, triggeredBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policyRepo.Save(policy); err != nil {
+		return nil, err
+	}
+
+	return replicationPolicyToResponse(policy), nil
+}
+
+// ListReplicationPoliciesUseCase retrieves every replication policy.
+type ListReplicationPoliciesUseCase struct {
+	policyRepo repositories.ReplicationPolicyRepository
+}
+
+// NewListReplicationPoliciesUseCase creates a new
+// ListReplicationPoliciesUseCase.
+func NewListReplicationPoliciesUseCase(policyRepo repositories.ReplicationPolicyRepository) *ListReplicationPoliciesUseCase {
+	return &ListReplicationPoliciesUseCase{policyRepo: policyRepo}
+}
+
+// Execute retrieves every replication policy known to the repository.
+func (uc *ListReplicationPoliciesUseCase) Execute() ([]*dto.ReplicationPolicyResponse, error) {
+	policies, err := uc.policyRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ReplicationPolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		responses = append(responses, replicationPolicyToResponse(policy))
+	}
+	return responses, nil
+}
+
+// TriggerReplicationUseCase runs a replication policy's push immediately,
+// outside its cron schedule.
+type TriggerReplicationUseCase struct {
+	policyRepo repositories.ReplicationPolicyRepository
+	trigger    ReplicationTrigger
+}
+
+// NewTriggerReplicationUseCase creates a new TriggerReplicationUseCase.
+func NewTriggerReplicationUseCase(policyRepo repositories.ReplicationPolicyRepository, trigger ReplicationTrigger) *TriggerReplicationUseCase {
+	return &TriggerReplicationUseCase{policyRepo: policyRepo, trigger: trigger}
+}
+
+// Execute triggers an immediate replication run of the policy with the
+// given id.
+func (uc *TriggerReplicationUseCase) Execute(ctx context.Context, id string) (*dto.ReplicationExecutionResponse, error) {
+	policy, err := uc.policyRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	execution, err := uc.trigger.TriggerNow(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return executionToResponse(execution), nil
+}
+
+// ListReplicationExecutionsUseCase retrieves every replication execution,
+// most recently created first.
+type ListReplicationExecutionsUseCase struct {
+	executionRepo repositories.ReplicationExecutionRepository
+}
+
+// NewListReplicationExecutionsUseCase creates a new
+// ListReplicationExecutionsUseCase.
+func NewListReplicationExecutionsUseCase(executionRepo repositories.ReplicationExecutionRepository) *ListReplicationExecutionsUseCase {
+	return &ListReplicationExecutionsUseCase{executionRepo: executionRepo}
+}
+
+// Execute retrieves every replication execution known to the repository.
+func (uc *ListReplicationExecutionsUseCase) Execute() ([]*dto.ReplicationExecutionResponse, error) {
+	executions, err := uc.executionRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ReplicationExecutionResponse, 0, len(executions))
+	for _, execution := range executions {
+		responses = append(responses, executionToResponse(execution))
+	}
+	return responses, nil
+}
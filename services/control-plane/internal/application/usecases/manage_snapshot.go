@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// snapshotToResponse maps a domain SnapshotManifest to its DTO representation.
+func snapshotToResponse(manifest *entities.SnapshotManifest) *dto.SnapshotResponse {
+	return &dto.SnapshotResponse{
+		ID:           manifest.ID,
+		TenantID:     manifest.TenantID,
+		SizeBytes:    manifest.SizeBytes,
+		SHA256:       manifest.SHA256,
+		CreatedAt:    manifest.CreatedAt,
+		SourceOffset: manifest.SourceOffset,
+	}
+}
+
+// ListSnapshotsUseCase retrieves a page of snapshot manifests.
+type ListSnapshotsUseCase struct {
+	snapshotRepo repositories.SnapshotRepository
+}
+
+// NewListSnapshotsUseCase creates a new ListSnapshotsUseCase.
+func NewListSnapshotsUseCase(snapshotRepo repositories.SnapshotRepository) *ListSnapshotsUseCase {
+	return &ListSnapshotsUseCase{snapshotRepo: snapshotRepo}
+}
+
+// Execute retrieves a page of snapshot manifests matching req.
+func (uc *ListSnapshotsUseCase) Execute(ctx context.Context, req dto.ListSnapshotsRequest) (*dto.ListSnapshotsResponse, error) {
+	manifests, nextToken, err := uc.snapshotRepo.List(ctx, repositories.ListOptions{
+		TenantID:  req.TenantID,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.SnapshotResponse, 0, len(manifests))
+	for _, manifest := range manifests {
+		responses = append(responses, snapshotToResponse(manifest))
+	}
+	return &dto.ListSnapshotsResponse{Snapshots: responses, NextPageToken: nextToken}, nil
+}
+
+// GetSnapshotUseCase retrieves a single snapshot manifest by ID.
+type GetSnapshotUseCase struct {
+	snapshotRepo repositories.SnapshotRepository
+}
+
+// NewGetSnapshotUseCase creates a new GetSnapshotUseCase.
+func NewGetSnapshotUseCase(snapshotRepo repositories.SnapshotRepository) *GetSnapshotUseCase {
+	return &GetSnapshotUseCase{snapshotRepo: snapshotRepo}
+}
+
+// Execute retrieves the snapshot manifest with the given id.
+func (uc *GetSnapshotUseCase) Execute(id string) (*dto.SnapshotResponse, error) {
+	manifest, err := uc.snapshotRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return snapshotToResponse(manifest), nil
+}
@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// auditEventToResponse maps a domain AuditEvent to its DTO representation.
+func auditEventToResponse(event *entities.AuditEvent) dto.AuditEventResponse {
+	return dto.AuditEventResponse{
+		ID:         event.ID,
+		Timestamp:  event.Timestamp,
+		EventType:  event.EventType,
+		UserID:     event.UserID,
+		Username:   event.Username,
+		TenantID:   event.TenantID,
+		Action:     event.Action,
+		Resource:   event.Resource,
+		ResourceID: event.ResourceID,
+		Method:     event.Method,
+		Path:       event.Path,
+		StatusCode: event.StatusCode,
+		Duration:   event.Duration,
+		IPAddress:  event.IPAddress,
+		UserAgent:  event.UserAgent,
+		Error:      event.Error,
+		Metadata:   event.Metadata,
+	}
+}
+
+// AuditQueryUseCase runs a filtered, cursor-paginated query over an
+// AuditRepository.
+type AuditQueryUseCase struct {
+	auditRepo repositories.AuditRepository
+}
+
+// NewAuditQueryUseCase creates a new AuditQueryUseCase.
+func NewAuditQueryUseCase(auditRepo repositories.AuditRepository) *AuditQueryUseCase {
+	return &AuditQueryUseCase{auditRepo: auditRepo}
+}
+
+// Execute translates req into a repositories.AuditQuery and returns the
+// resulting page.
+func (uc *AuditQueryUseCase) Execute(req dto.AuditQueryRequest) (*dto.AuditPageResponse, error) {
+	if req.ErrorsOnly && req.SuccessOnly {
+		return nil, domain.Wrap(nil, domain.CodeValidationFailed, "errors_only and success_only are mutually exclusive")
+	}
+
+	q := repositories.AuditQuery{
+		UserID:           req.UserID,
+		TenantID:         req.TenantID,
+		Resource:         req.Resource,
+		Action:           req.Action,
+		MinStatusCode:    req.MinStatusCode,
+		MaxStatusCode:    req.MaxStatusCode,
+		MetadataContains: req.Metadata,
+		PageSize:         req.PageSize,
+	}
+
+	if req.ErrorsOnly {
+		isError := true
+		q.IsError = &isError
+	}
+	if req.SuccessOnly {
+		isError := false
+		q.IsError = &isError
+	}
+
+	var err error
+	if q.Start, err = parseAuditTimeOrZero(req.Since); err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid since")
+	}
+	if q.End, err = parseAuditTimeOrZero(req.Until); err != nil {
+		return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid until")
+	}
+
+	switch req.SortOrder {
+	case "", "desc":
+		q.SortOrder = repositories.SortDesc
+	case "asc":
+		q.SortOrder = repositories.SortAsc
+	default:
+		return nil, domain.Wrap(nil, domain.CodeValidationFailed, "sort must be \"asc\" or \"desc\"")
+	}
+
+	if req.Cursor != "" {
+		cursor, err := repositories.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, domain.Wrap(err, domain.CodeValidationFailed, "invalid cursor")
+		}
+		q.Cursor = &cursor
+	}
+
+	page, err := uc.auditRepo.Query(q)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]dto.AuditEventResponse, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, auditEventToResponse(event))
+	}
+
+	return &dto.AuditPageResponse{
+		Events:     events,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// parseAuditTimeOrZero parses s as RFC3339, returning the zero Time (not
+// an error) for an empty string.
+func parseAuditTimeOrZero(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
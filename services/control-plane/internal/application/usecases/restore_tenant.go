@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// RestoreTenantUseCase reactivates a soft-deleted tenant
+type RestoreTenantUseCase struct {
+	tenantRepo repositories.TenantRepository
+	auditRepo  repositories.AuditRepository
+}
+
+// NewRestoreTenantUseCase creates a new RestoreTenantUseCase
+func NewRestoreTenantUseCase(
+	tenantRepo repositories.TenantRepository,
+	auditRepo repositories.AuditRepository,
+) *RestoreTenantUseCase {
+	return &RestoreTenantUseCase{
+		tenantRepo: tenantRepo,
+		auditRepo:  auditRepo,
+	}
+}
+
+// Execute restores the tenant identified by req.ID. If req carries a
+// non-admin RequestedByTenantID, it must match req.ID: a tenant-scoped
+// caller may only restore its own tenant, never another one it happens to
+// know the ID of.
+func (uc *RestoreTenantUseCase) Execute(req dto.RestoreTenantRequest) (*dto.TenantResponse, error) {
+	if req.RequestedByTenantID != "" && !req.RequestedByIsAdmin && req.RequestedByTenantID != req.ID {
+		return nil, domain.ErrForbidden
+	}
+
+	tenant, err := uc.tenantRepo.FindByIDIncludingDeleted(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tenantRepo.Restore(tenant.ID); err != nil {
+		return nil, domain.Wrap(err, domain.CodeInternal, "failed to restore tenant")
+	}
+
+	auditEvent, _ := entities.NewAuditEvent("tenant.restored", "update", "POST", "/tenants/"+tenant.ID+"/restore")
+	auditEvent.WithResource("tenant", tenant.ID).WithTenant(tenant.ID)
+	_ = uc.auditRepo.Log(auditEvent)
+
+	return &dto.TenantResponse{
+		ID:          tenant.ID,
+		Name:        tenant.Name,
+		Description: tenant.Description,
+		Status:      string(tenant.Status),
+		CreatedAt:   tenant.CreatedAt,
+		UpdatedAt:   tenant.UpdatedAt,
+		Metadata:    tenant.Metadata,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/infrastructure/persistence"
+)
+
+func TestRestoreTenantUseCase_Execute(t *testing.T) {
+	tenantRepo := persistence.NewMemoryTenantRepository()
+	auditRepo := persistence.NewMemoryAuditRepository()
+	createUC := NewCreateTenantUseCase(tenantRepo, auditRepo)
+	restoreUC := NewRestoreTenantUseCase(tenantRepo, auditRepo)
+
+	if _, err := createUC.Execute(dto.CreateTenantRequest{ID: "tenant-1", Name: "Test Tenant"}); err != nil {
+		t.Fatalf("setup: create tenant failed: %v", err)
+	}
+
+	t.Run("Restore soft-deleted tenant", func(t *testing.T) {
+		if err := tenantRepo.Delete("tenant-1"); err != nil {
+			t.Fatalf("setup: delete tenant failed: %v", err)
+		}
+
+		resp, err := restoreUC.Execute(dto.RestoreTenantRequest{ID: "tenant-1"})
+		if err != nil {
+			t.Fatalf("Execute() failed: %v", err)
+		}
+		if resp.Status != "active" {
+			t.Errorf("Response.Status = %v, want active", resp.Status)
+		}
+
+		if _, err := tenantRepo.FindByID("tenant-1"); err != nil {
+			t.Errorf("Restored tenant should be visible via FindByID: %v", err)
+		}
+	})
+
+	t.Run("Restore unknown tenant", func(t *testing.T) {
+		_, err := restoreUC.Execute(dto.RestoreTenantRequest{ID: "does-not-exist"})
+		if err != domain.ErrTenantNotFound {
+			t.Errorf("Expected ErrTenantNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// SimulatePolicyUseCase evaluates every enabled policy for a resource and
+// reports the full decision trace, rather than only the single combined
+// decision EvaluatePolicyUseCase returns. This lets operators test a
+// proposed policy change against historical contexts and see near-misses,
+// which is otherwise invisible when priority conflicts arise.
+type SimulatePolicyUseCase struct {
+	policyRepo repositories.PolicyRepository
+}
+
+// NewSimulatePolicyUseCase creates a new SimulatePolicyUseCase.
+func NewSimulatePolicyUseCase(policyRepo repositories.PolicyRepository) *SimulatePolicyUseCase {
+	return &SimulatePolicyUseCase{policyRepo: policyRepo}
+}
+
+// Execute evaluates policies for a given resource and attributes, tracing
+// every policy considered.
+func (uc *SimulatePolicyUseCase) Execute(req dto.SimulatePolicyRequest) (*dto.SimulatePolicyResponse, error) {
+	policies, err := uc.policyRepo.FindByResource(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledPolicies := make([]*entities.Policy, 0, len(policies))
+	for _, p := range policies {
+		if p.Enabled {
+			enabledPolicies = append(enabledPolicies, p)
+		}
+	}
+
+	sort.Slice(enabledPolicies, func(i, j int) bool {
+		return enabledPolicies[i].Priority > enabledPolicies[j].Priority
+	})
+
+	resp := &dto.SimulatePolicyResponse{}
+
+	for _, policy := range enabledPolicies {
+		conditionResults := make([]bool, len(policy.Conditions))
+		conditions := make([]dto.PolicyConditionDTO, len(policy.Conditions))
+
+		for i, condition := range policy.Conditions {
+			conditions[i] = dto.PolicyConditionDTO{
+				Field:    condition.Field,
+				Operator: condition.Operator,
+				Value:    condition.Value,
+			}
+			conditionResults[i] = conditionMatches(condition, req.Attributes)
+		}
+
+		matched, err := policy.Evaluate(req.Attributes)
+		if err != nil {
+			matched = false
+		}
+
+		resp.PoliciesTraced = append(resp.PoliciesTraced, dto.PolicyTraceDTO{
+			PolicyID:         policy.ID,
+			Name:             policy.Name,
+			Priority:         policy.Priority,
+			Action:           string(policy.Action),
+			Matched:          matched,
+			ConditionResults: conditionResults,
+			Conditions:       conditions,
+		})
+	}
+
+	// Decide through the same PolicySet.Decide path EvaluatePolicyUseCase
+	// uses, so the decision this dry-run reports (Allowed/MatchedID/Action)
+	// is the one enforcement would actually make (deny-overrides, unless a
+	// different algorithm is requested), not a separate first-match-by-
+	// priority approximation that can disagree with it.
+	algorithm := entities.CombiningAlgorithm(req.CombiningAlgorithm)
+	decision := entities.NewPolicySet(enabledPolicies).Decide(context.Background(), req.Attributes, algorithm)
+	resp.Allowed = decision.Effect != entities.ActionDeny
+	resp.MatchedID = decision.MatchedPolicyID
+	resp.Action = string(decision.Effect)
+
+	return resp, nil
+}
+
+// conditionMatches mirrors entities.Policy's unexported per-condition
+// evaluation (eq/ne/contains over fmt.Sprintf string forms) so the
+// simulation trace can report per-condition results without entities
+// needing to export that detail.
+func conditionMatches(condition entities.PolicyCondition, attributes map[string]interface{}) bool {
+	attrValue, exists := attributes[condition.Field]
+	if !exists {
+		return false
+	}
+
+	attrStr := fmt.Sprintf("%v", attrValue)
+	valueStr := fmt.Sprintf("%v", condition.Value)
+
+	switch condition.Operator {
+	case "eq":
+		return attrStr == valueStr
+	case "ne":
+		return attrStr != valueStr
+	case "contains":
+		return len(attrStr) >= len(valueStr) && indexOfSubstring(attrStr, valueStr) >= 0
+	default:
+		return false
+	}
+}
+
+func indexOfSubstring(s, substr string) int {
+	for i := 0; i <= len(s)-len(substr); i++ {
+		if s[i:i+len(substr)] == substr {
+			return i
+		}
+	}
+	return -1
+}
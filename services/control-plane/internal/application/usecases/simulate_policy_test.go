@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/infrastructure/persistence"
+)
+
+func TestSimulatePolicyUseCase_Execute(t *testing.T) {
+	policyRepo := persistence.NewMemoryPolicyRepository()
+	useCase := NewSimulatePolicyUseCase(policyRepo)
+
+	t.Run("Traces deny and reports near misses", func(t *testing.T) {
+		req := dto.SimulatePolicyRequest{
+			Resource: "tenant",
+			Attributes: map[string]interface{}{
+				"tenant_id": "default",
+				"operation": "delete",
+			},
+		}
+
+		resp, err := useCase.Execute(req)
+		if err != nil {
+			t.Fatalf("Execute() failed: %v", err)
+		}
+
+		if resp.Allowed {
+			t.Error("Should not allow deletion of default tenant")
+		}
+		if resp.MatchedID != "prevent-default-tenant-deletion" {
+			t.Errorf("MatchedID = %v, want prevent-default-tenant-deletion", resp.MatchedID)
+		}
+		if len(resp.PoliciesTraced) == 0 {
+			t.Error("Expected at least one traced policy")
+		}
+
+		found := false
+		for _, trace := range resp.PoliciesTraced {
+			if trace.PolicyID == "prevent-default-tenant-deletion" {
+				found = true
+				if !trace.Matched {
+					t.Error("Expected winning policy to be marked matched in trace")
+				}
+			}
+		}
+		if !found {
+			t.Error("Expected winning policy to appear in trace")
+		}
+	})
+
+	t.Run("Default allow traces all non-matching policies", func(t *testing.T) {
+		req := dto.SimulatePolicyRequest{
+			Resource: "tenant",
+			Attributes: map[string]interface{}{
+				"tenant_id": "tenant-1",
+				"operation": "delete",
+			},
+		}
+
+		resp, err := useCase.Execute(req)
+		if err != nil {
+			t.Fatalf("Execute() failed: %v", err)
+		}
+
+		if !resp.Allowed {
+			t.Error("Should allow deletion of non-default tenant")
+		}
+		for _, trace := range resp.PoliciesTraced {
+			if trace.PolicyID == "prevent-default-tenant-deletion" && trace.Matched {
+				t.Error("prevent-default-tenant-deletion should not have matched a non-default tenant")
+			}
+		}
+	})
+}
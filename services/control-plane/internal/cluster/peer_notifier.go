@@ -0,0 +1,227 @@
+// Package cluster implements gossip-style cache invalidation between
+// control-plane replicas. When one replica commits a mutation that a
+// peer's in-memory caches might have a stale copy of, PeerNotifier signs
+// an Event describing it and POSTs it to every peer's NotifyPath, so the
+// peer can invalidate just the targeted ID instead of waiting out its own
+// cache TTL or reloading everything.
+package cluster
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Event types carried by a gossip Event.
+const (
+	EventUserDeleted    = "user.deleted"
+	EventUserUpdated    = "user.updated"
+	EventTenantUpdated  = "tenant.updated"
+	EventTenantDeleted  = "tenant.deleted"
+	EventPolicyReloaded = "policy.reloaded"
+)
+
+// NotifyPath is the HTTP path a peer's gossip receiver listens on,
+// relative to its base URL.
+const NotifyPath = "/internal/v1/notify"
+
+// Event is the signed gossip message PeerNotifier fans out to every peer.
+type Event struct {
+	Type      string    `json:"type"`
+	TargetID  string    `json:"target_id,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Signature []byte    `json:"signature"`
+}
+
+// signingPayload returns the canonical bytes NewEvent signs and Verify
+// checks: every field but Signature itself.
+func signingPayload(e Event) ([]byte, error) {
+	payload := struct {
+		Type      string    `json:"type"`
+		TargetID  string    `json:"target_id,omitempty"`
+		Timestamp time.Time `json:"timestamp"`
+	}{e.Type, e.TargetID, e.Timestamp}
+	return json.Marshal(payload)
+}
+
+// NewEvent builds and signs an Event. signingKey may be nil, in which case
+// the event carries an empty Signature and any properly configured peer
+// rejects it.
+func NewEvent(eventType, targetID string, signingKey ed25519.PrivateKey) Event {
+	event := Event{Type: eventType, TargetID: targetID, Timestamp: time.Now().UTC()}
+	if signingKey == nil {
+		return event
+	}
+
+	payload, err := signingPayload(event)
+	if err != nil {
+		return event
+	}
+	event.Signature = ed25519.Sign(signingKey, payload)
+	return event
+}
+
+// Verify reports whether e's signature verifies against at least one of
+// trustedKeys.
+func Verify(e Event, trustedKeys []ed25519.PublicKey) (bool, error) {
+	payload, err := signingPayload(e)
+	if err != nil {
+		return false, fmt.Errorf("marshal notify event payload: %w", err)
+	}
+	for _, key := range trustedKeys {
+		if ed25519.Verify(key, payload, e.Signature) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PeerError records one peer's failure to receive a notify POST, collected
+// by Notify rather than returned as a single error so a caller can log
+// each failure without failing the mutation that triggered the fan-out.
+type PeerError struct {
+	Host string
+	Err  error
+}
+
+func (e PeerError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Host, e.Err)
+}
+
+// Recorder lets PeerNotifier report per-notify outcomes without depending
+// on a specific metrics library. ControlPlaneMetrics implements it via
+// RecordPeerNotify.
+type Recorder interface {
+	RecordPeerNotify(event, status string)
+}
+
+// noopRecorder discards every call, used when PeerNotifier is built
+// without a Recorder.
+type noopRecorder struct{}
+
+func (noopRecorder) RecordPeerNotify(event, status string) {}
+
+// PeerNotifier fans a signed Event out to every configured peer.
+type PeerNotifier struct {
+	Peers      []string
+	SigningKey ed25519.PrivateKey
+	Recorder   Recorder
+
+	client *resty.Client
+}
+
+// NewPeerNotifier creates a PeerNotifier. signingKey may be nil; Notify
+// still runs, but the events it sends carry an empty Signature, so any
+// properly configured peer rejects them until a signing key is set.
+// recorder may be nil, in which case notify outcomes aren't recorded.
+func NewPeerNotifier(peers []string, signingKey ed25519.PrivateKey, recorder Recorder) *PeerNotifier {
+	if recorder == nil {
+		recorder = noopRecorder{}
+	}
+	return &PeerNotifier{
+		Peers:      peers,
+		SigningKey: signingKey,
+		Recorder:   recorder,
+		client:     resty.New().SetTimeout(5 * time.Second),
+	}
+}
+
+// NewPeerNotifierFromEnv builds a PeerNotifier from CONTROL_PLANE_PEERS (a
+// comma-separated list of peer base URLs, e.g.
+// "http://cp-2:8081,http://cp-3:8081") and CONTROL_PLANE_PEER_SIGNING_KEY
+// (a base64-encoded Ed25519 seed). Both may be unset, producing a
+// PeerNotifier with no peers whose Notify calls are no-ops.
+func NewPeerNotifierFromEnv(recorder Recorder) *PeerNotifier {
+	return NewPeerNotifier(peersFromEnv(), signingKeyFromEnv(), recorder)
+}
+
+func peersFromEnv() []string {
+	raw := os.Getenv("CONTROL_PLANE_PEERS")
+	if raw == "" {
+		return nil
+	}
+
+	var peers []string
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			peers = append(peers, entry)
+		}
+	}
+	return peers
+}
+
+func signingKeyFromEnv() ed25519.PrivateKey {
+	raw := os.Getenv("CONTROL_PLANE_PEER_SIGNING_KEY")
+	if raw == "" {
+		return nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		fmt.Fprintf(os.Stderr, "peer notifier: CONTROL_PLANE_PEER_SIGNING_KEY must be a base64-encoded %d-byte Ed25519 seed, sending unsigned notify events\n", ed25519.SeedSize)
+		return nil
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+// TrustedKeysFromEnv decodes CONTROL_PLANE_PEER_TRUSTED_KEYS, a
+// comma-separated list of base64-encoded Ed25519 public keys, into the set
+// a notify receiver verifies incoming Events against. Malformed entries
+// are skipped with a warning rather than failing startup.
+func TrustedKeysFromEnv() []ed25519.PublicKey {
+	raw := os.Getenv("CONTROL_PLANE_PEER_TRUSTED_KEYS")
+	if raw == "" {
+		return nil
+	}
+
+	var keys []ed25519.PublicKey
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(entry)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			fmt.Fprintf(os.Stderr, "peer notifier: skipping malformed Ed25519 public key %q in CONTROL_PLANE_PEER_TRUSTED_KEYS\n", entry)
+			continue
+		}
+		keys = append(keys, ed25519.PublicKey(key))
+	}
+	return keys
+}
+
+// Notify signs an Event of the given type/targetID and POSTs it to every
+// configured peer, returning one PeerError per peer that didn't
+// acknowledge it.
+func (n *PeerNotifier) Notify(eventType, targetID string) []PeerError {
+	if len(n.Peers) == 0 {
+		return nil
+	}
+
+	event := NewEvent(eventType, targetID, n.SigningKey)
+
+	var errs []PeerError
+	for _, peer := range n.Peers {
+		resp, err := n.client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(event).
+			Post(strings.TrimRight(peer, "/") + NotifyPath)
+		if err == nil && resp.IsError() {
+			err = fmt.Errorf("peer responded with status %s", resp.Status())
+		}
+		if err != nil {
+			n.Recorder.RecordPeerNotify(eventType, "error")
+			errs = append(errs, PeerError{Host: peer, Err: err})
+			continue
+		}
+		n.Recorder.RecordPeerNotify(eventType, "success")
+	}
+	return errs
+}
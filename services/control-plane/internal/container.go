@@ -1,27 +1,164 @@
 package internal
 
 import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/allsource/control-plane/internal/application/usecases"
+	"github.com/allsource/control-plane/internal/domain/entities"
 	"github.com/allsource/control-plane/internal/domain/repositories"
 	"github.com/allsource/control-plane/internal/infrastructure/persistence"
+	"github.com/allsource/control-plane/internal/infrastructure/replication"
+	"github.com/allsource/control-plane/internal/infrastructure/workers"
 	httphandlers "github.com/allsource/control-plane/internal/interfaces/http"
 )
 
 // Container holds all application dependencies
 type Container struct {
 	// Repositories
-	TenantRepo repositories.TenantRepository
-	UserRepo   repositories.UserRepository
-	PolicyRepo repositories.PolicyRepository
-	AuditRepo  repositories.AuditRepository
+	TenantRepo               repositories.TenantRepository
+	UserRepo                 repositories.UserRepository
+	PolicyRepo               repositories.PolicyRepository
+	AuditRepo                repositories.AuditRepository
+	JobRepo                  repositories.JobRepository
+	JobExecutionRepo         repositories.JobExecutionRepository
+	SnapshotRepo             repositories.SnapshotRepository
+	ReplicationTargetRepo    repositories.ReplicationTargetRepository
+	ReplicationPolicyRepo    repositories.ReplicationPolicyRepository
+	ReplicationExecutionRepo repositories.ReplicationExecutionRepository
+
+	// PolicySet is the live, hot-reloadable policy set used by
+	// PolicyEnforcementMiddleware. Policy CRUD use cases reload it from
+	// PolicyRepo after every mutation.
+	PolicySet *entities.PolicySet
 
 	// Use Cases
-	CreateTenantUC   *usecases.CreateTenantUseCase
-	EvaluatePolicyUC *usecases.EvaluatePolicyUseCase
+	CreateTenantUC      *usecases.CreateTenantUseCase
+	RestoreTenantUC     *usecases.RestoreTenantUseCase
+	EvaluatePolicyUC    *usecases.EvaluatePolicyUseCase
+	SimulatePolicyUC    *usecases.SimulatePolicyUseCase
+	CreatePolicyUC      *usecases.CreatePolicyUseCase
+	UpdatePolicyUC      *usecases.UpdatePolicyUseCase
+	DeletePolicyUC      *usecases.DeletePolicyUseCase
+	ListPoliciesUC      *usecases.ListPoliciesUseCase
+	GetPolicyUC         *usecases.GetPolicyUseCase
+	SetPolicyEnabledUC  *usecases.SetPolicyEnabledUseCase
+	GetPolicyBindingsUC *usecases.GetPolicyBindingsUseCase
+	UnbindPolicyUC      *usecases.UnbindPolicyUseCase
+	TestPolicyUC        *usecases.TestPolicyUseCase
+	CreateJobUC         *usecases.CreateJobUseCase
+	GetJobUC            *usecases.GetJobUseCase
+	ListJobsUC          *usecases.ListJobsUseCase
+	CancelJobUC         *usecases.CancelJobUseCase
+	ListJobExecutionsUC *usecases.ListJobExecutionsUseCase
+	ListSnapshotsUC     *usecases.ListSnapshotsUseCase
+	GetSnapshotUC       *usecases.GetSnapshotUseCase
+
+	CreateReplicationTargetUC   *usecases.CreateReplicationTargetUseCase
+	ListReplicationTargetsUC    *usecases.ListReplicationTargetsUseCase
+	CreateReplicationPolicyUC   *usecases.CreateReplicationPolicyUseCase
+	ListReplicationPoliciesUC   *usecases.ListReplicationPoliciesUseCase
+	TriggerReplicationUC        *usecases.TriggerReplicationUseCase
+	ListReplicationExecutionsUC *usecases.ListReplicationExecutionsUseCase
 
 	// HTTP Handlers
-	TenantHandler *httphandlers.TenantHandler
-	PolicyHandler *httphandlers.PolicyHandler
+	TenantHandler      *httphandlers.TenantHandler
+	PolicyHandler      *httphandlers.PolicyHandler
+	JobHandler         *httphandlers.JobHandler
+	SnapshotHandler    *httphandlers.SnapshotHandler
+	ReplicationHandler *httphandlers.ReplicationHandler
+
+	// Background workers
+	TenantPurgeWorker    *workers.TenantPurgeWorker
+	JobDispatcher        *workers.JobDispatcher
+	ReplicationPusher    *replication.Pusher
+	ReplicationScheduler *replication.Scheduler
+	ReplicationReceiver  *replication.Receiver
+}
+
+// newPolicyRepository returns a persistence.FilePolicyRepository reading
+// from POLICY_FILE_PATH if set (read-only unless POLICY_FILE_WRITABLE is
+// "true"), or persistence.NewMemoryPolicyRepository otherwise. A file that
+// fails to load falls back to the in-memory store rather than preventing
+// startup, matching newSnapshotStore's fallback-on-error convention in
+// main.go.
+func newPolicyRepository() repositories.PolicyRepository {
+	path := os.Getenv("POLICY_FILE_PATH")
+	if path == "" {
+		return persistence.NewMemoryPolicyRepository()
+	}
+
+	readOnly := os.Getenv("POLICY_FILE_WRITABLE") != "true"
+	repo, err := persistence.NewFilePolicyRepository(path, readOnly)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "policy repository: failed to load %s, falling back to in-memory store: %v\n", path, err)
+		return persistence.NewMemoryPolicyRepository()
+	}
+	return repo
+}
+
+// maxJobWorkers reads MAX_JOB_WORKERS from the environment, falling back
+// to workers.DefaultJobWorkers if unset or invalid.
+func maxJobWorkers() int {
+	raw := os.Getenv("MAX_JOB_WORKERS")
+	if raw == "" {
+		return workers.DefaultJobWorkers
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		return workers.DefaultJobWorkers
+	}
+	return n
+}
+
+// newReplicationSigningKey decodes REPLICATION_SIGNING_KEY, a base64-encoded
+// Ed25519 seed, into the private key replication.Pusher signs outgoing
+// bundles with. It returns nil (leaving bundles unsigned, so any properly
+// configured receiving peer rejects them) if the variable is unset or
+// malformed, mirroring main.go's newBundleSigningKey.
+func newReplicationSigningKey() ed25519.PrivateKey {
+	raw := os.Getenv("REPLICATION_SIGNING_KEY")
+	if raw == "" {
+		return nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		fmt.Fprintf(os.Stderr, "replication signing key: REPLICATION_SIGNING_KEY must be a base64-encoded %d-byte Ed25519 seed, pushing unsigned bundles\n", ed25519.SeedSize)
+		return nil
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+// newReplicationTrustedKeys decodes REPLICATION_TRUSTED_KEYS, a
+// comma-separated list of base64-encoded Ed25519 public keys, into the set
+// replication.Receiver verifies incoming bundles against. Malformed entries
+// are skipped with a warning rather than failing startup, mirroring
+// main.go's newBundleTrustedKeys.
+func newReplicationTrustedKeys() []ed25519.PublicKey {
+	raw := os.Getenv("REPLICATION_TRUSTED_KEYS")
+	if raw == "" {
+		return nil
+	}
+
+	var keys []ed25519.PublicKey
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(entry)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			fmt.Fprintf(os.Stderr, "replication trusted keys: skipping malformed Ed25519 public key %q\n", entry)
+			continue
+		}
+		keys = append(keys, ed25519.PublicKey(key))
+	}
+	return keys
 }
 
 // NewContainer creates and wires up all dependencies
@@ -29,25 +166,132 @@ func NewContainer() *Container {
 	// Initialize repositories (Layer 3)
 	tenantRepo := persistence.NewMemoryTenantRepository()
 	userRepo := persistence.NewMemoryUserRepository()
-	policyRepo := persistence.NewMemoryPolicyRepository()
+	policyRepo := newPolicyRepository()
 	auditRepo := persistence.NewMemoryAuditRepository()
+	jobRepo := persistence.NewMemoryJobRepository()
+	jobExecutionRepo := persistence.NewMemoryJobExecutionRepository()
+	snapshotRepo := persistence.NewMemorySnapshotRepository()
+	replicationTargetRepo := persistence.NewMemoryReplicationTargetRepository()
+	replicationPolicyRepo := persistence.NewMemoryReplicationPolicyRepository()
+	replicationExecutionRepo := persistence.NewMemoryReplicationExecutionRepository()
+
+	// The live policy set used for request-time enforcement. It is seeded
+	// from whatever policies already exist and reloaded by the Policy CRUD
+	// use cases below whenever they mutate policyRepo.
+	policies, _ := policyRepo.FindAll()
+	policySet := entities.NewPolicySet(policies)
 
 	// Initialize use cases (Layer 2)
 	createTenantUC := usecases.NewCreateTenantUseCase(tenantRepo, auditRepo)
+	restoreTenantUC := usecases.NewRestoreTenantUseCase(tenantRepo, auditRepo)
 	evaluatePolicyUC := usecases.NewEvaluatePolicyUseCase(policyRepo)
+	simulatePolicyUC := usecases.NewSimulatePolicyUseCase(policyRepo)
+	createPolicyUC := usecases.NewCreatePolicyUseCase(policyRepo, auditRepo, policySet)
+	updatePolicyUC := usecases.NewUpdatePolicyUseCase(policyRepo, auditRepo, policySet)
+	deletePolicyUC := usecases.NewDeletePolicyUseCase(policyRepo, auditRepo, policySet)
+	listPoliciesUC := usecases.NewListPoliciesUseCase(policyRepo)
+	getPolicyUC := usecases.NewGetPolicyUseCase(policyRepo)
+	setPolicyEnabledUC := usecases.NewSetPolicyEnabledUseCase(policyRepo, auditRepo, policySet)
+	getPolicyBindingsUC := usecases.NewGetPolicyBindingsUseCase(policyRepo)
+	unbindPolicyUC := usecases.NewUnbindPolicyUseCase(policyRepo, auditRepo, policySet)
+	testPolicyUC := usecases.NewTestPolicyUseCase()
+
+	// The job dispatcher is wired into the job use cases before it's
+	// started (Start is called by main once the HTTP server comes up), so
+	// CreateJobUC/CancelJobUC can Enqueue/Cancel against it immediately.
+	jobDispatcher := workers.NewJobDispatcher(jobRepo, auditRepo, jobExecutionRepo, maxJobWorkers())
+	createJobUC := usecases.NewCreateJobUseCase(jobRepo, jobDispatcher)
+	getJobUC := usecases.NewGetJobUseCase(jobRepo)
+	listJobsUC := usecases.NewListJobsUseCase(jobRepo)
+	cancelJobUC := usecases.NewCancelJobUseCase(jobRepo, jobDispatcher)
+	listJobExecutionsUC := usecases.NewListJobExecutionsUseCase(jobExecutionRepo)
+	listSnapshotsUC := usecases.NewListSnapshotsUseCase(snapshotRepo)
+	getSnapshotUC := usecases.NewGetSnapshotUseCase(snapshotRepo)
+
+	// The replication pusher/scheduler/receiver are wired into the
+	// replication use cases before they're started (Run/Start are called by
+	// main once the HTTP server comes up), same as jobDispatcher above.
+	replicationSigningKey := newReplicationSigningKey()
+	replicationTrustedKeys := newReplicationTrustedKeys()
+	replicationPusher := replication.NewPusher(policyRepo, replicationTargetRepo, replicationPolicyRepo, replicationExecutionRepo, replicationSigningKey)
+	replicationScheduler := replication.NewScheduler(replicationPolicyRepo, replicationPusher)
+	replicationReceiver := replication.NewReceiver(policyRepo, replicationTrustedKeys)
+
+	createReplicationTargetUC := usecases.NewCreateReplicationTargetUseCase(replicationTargetRepo)
+	listReplicationTargetsUC := usecases.NewListReplicationTargetsUseCase(replicationTargetRepo)
+	createReplicationPolicyUC := usecases.NewCreateReplicationPolicyUseCase(replicationPolicyRepo, replicationTargetRepo)
+	listReplicationPoliciesUC := usecases.NewListReplicationPoliciesUseCase(replicationPolicyRepo)
+	triggerReplicationUC := usecases.NewTriggerReplicationUseCase(replicationPolicyRepo, replicationScheduler)
+	listReplicationExecutionsUC := usecases.NewListReplicationExecutionsUseCase(replicationExecutionRepo)
 
 	// Initialize HTTP handlers (Layer 4)
-	tenantHandler := httphandlers.NewTenantHandler(createTenantUC)
-	policyHandler := httphandlers.NewPolicyHandler(evaluatePolicyUC)
+	tenantHandler := httphandlers.NewTenantHandler(createTenantUC, restoreTenantUC)
+	policyHandler := httphandlers.NewPolicyHandler(
+		evaluatePolicyUC, simulatePolicyUC,
+		createPolicyUC, updatePolicyUC, deletePolicyUC, listPoliciesUC, getPolicyUC,
+		setPolicyEnabledUC, getPolicyBindingsUC, unbindPolicyUC, testPolicyUC,
+	)
+	jobHandler := httphandlers.NewJobHandler(createJobUC, getJobUC, listJobsUC, cancelJobUC, listJobExecutionsUC)
+	snapshotHandler := httphandlers.NewSnapshotHandler(listSnapshotsUC, getSnapshotUC)
+	replicationHandler := httphandlers.NewReplicationHandler(
+		createReplicationTargetUC, listReplicationTargetsUC,
+		createReplicationPolicyUC, listReplicationPoliciesUC,
+		triggerReplicationUC, listReplicationExecutionsUC,
+	)
+
+	// Initialize background workers
+	tenantPurgeWorker := workers.NewTenantPurgeWorker(tenantRepo, userRepo, auditRepo, 0)
 
 	return &Container{
-		TenantRepo:       tenantRepo,
-		UserRepo:         userRepo,
-		PolicyRepo:       policyRepo,
-		AuditRepo:        auditRepo,
-		CreateTenantUC:   createTenantUC,
-		EvaluatePolicyUC: evaluatePolicyUC,
-		TenantHandler:    tenantHandler,
-		PolicyHandler:    policyHandler,
+		TenantRepo:               tenantRepo,
+		UserRepo:                 userRepo,
+		PolicyRepo:               policyRepo,
+		AuditRepo:                auditRepo,
+		JobRepo:                  jobRepo,
+		JobExecutionRepo:         jobExecutionRepo,
+		SnapshotRepo:             snapshotRepo,
+		ReplicationTargetRepo:    replicationTargetRepo,
+		ReplicationPolicyRepo:    replicationPolicyRepo,
+		ReplicationExecutionRepo: replicationExecutionRepo,
+		PolicySet:                policySet,
+		CreateTenantUC:           createTenantUC,
+		RestoreTenantUC:          restoreTenantUC,
+		EvaluatePolicyUC:         evaluatePolicyUC,
+		SimulatePolicyUC:         simulatePolicyUC,
+		CreatePolicyUC:           createPolicyUC,
+		UpdatePolicyUC:           updatePolicyUC,
+		DeletePolicyUC:           deletePolicyUC,
+		ListPoliciesUC:           listPoliciesUC,
+		GetPolicyUC:              getPolicyUC,
+		SetPolicyEnabledUC:       setPolicyEnabledUC,
+		GetPolicyBindingsUC:      getPolicyBindingsUC,
+		UnbindPolicyUC:           unbindPolicyUC,
+		TestPolicyUC:             testPolicyUC,
+		CreateJobUC:              createJobUC,
+		GetJobUC:                 getJobUC,
+		ListJobsUC:               listJobsUC,
+		CancelJobUC:              cancelJobUC,
+		ListJobExecutionsUC:      listJobExecutionsUC,
+		ListSnapshotsUC:          listSnapshotsUC,
+		GetSnapshotUC:            getSnapshotUC,
+
+		CreateReplicationTargetUC:   createReplicationTargetUC,
+		ListReplicationTargetsUC:    listReplicationTargetsUC,
+		CreateReplicationPolicyUC:   createReplicationPolicyUC,
+		ListReplicationPoliciesUC:   listReplicationPoliciesUC,
+		TriggerReplicationUC:        triggerReplicationUC,
+		ListReplicationExecutionsUC: listReplicationExecutionsUC,
+
+		TenantHandler:      tenantHandler,
+		PolicyHandler:      policyHandler,
+		JobHandler:         jobHandler,
+		SnapshotHandler:    snapshotHandler,
+		ReplicationHandler: replicationHandler,
+
+		TenantPurgeWorker:    tenantPurgeWorker,
+		JobDispatcher:        jobDispatcher,
+		ReplicationPusher:    replicationPusher,
+		ReplicationScheduler: replicationScheduler,
+		ReplicationReceiver:  replicationReceiver,
 	}
 }
@@ -0,0 +1,115 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessRequestState is the lifecycle state of an AccessRequest.
+type AccessRequestState string
+
+const (
+	AccessRequestPending  AccessRequestState = "PENDING"
+	AccessRequestApproved AccessRequestState = "APPROVED"
+	AccessRequestDenied   AccessRequestState = "DENIED"
+	AccessRequestApplied  AccessRequestState = "APPLIED"
+	AccessRequestExpired  AccessRequestState = "EXPIRED"
+)
+
+// AccessRequest is a time-bounded request for a non-admin caller to run a
+// privileged operation (snapshot, replay, backup) that would otherwise
+// require PermissionAdmin outright. It moves PENDING -> APPROVED once
+// enough distinct admins have approved it, PENDING -> DENIED if an admin
+// rejects it, PENDING -> EXPIRED if neither happens before ExpiresAt, and
+// APPROVED -> APPLIED once the operation it gates has actually run.
+type AccessRequest struct {
+	ID        string
+	Requester string
+	Operation string
+	Params    map[string]interface{}
+	State     AccessRequestState
+	Approvers []string
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// NewAccessRequest creates a new, pending AccessRequest for operation on
+// behalf of requester, expiring ttl from now.
+func NewAccessRequest(requester, operation string, params map[string]interface{}, ttl time.Duration) (*AccessRequest, error) {
+	if requester == "" {
+		return nil, fmt.Errorf("access request requester cannot be empty")
+	}
+	if operation == "" {
+		return nil, fmt.Errorf("access request operation cannot be empty")
+	}
+
+	now := time.Now()
+	return &AccessRequest{
+		ID:        uuid.NewString(),
+		Requester: requester,
+		Operation: operation,
+		Params:    params,
+		State:     AccessRequestPending,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}, nil
+}
+
+// Approve records approver's vote, transitioning the request to APPROVED
+// once it has collected threshold distinct approvers. Only a PENDING
+// request accepts votes; the same approver voting twice is a no-op rather
+// than an error, so a retried request doesn't fail.
+func (r *AccessRequest) Approve(approver string, threshold int) error {
+	if r.State != AccessRequestPending {
+		return fmt.Errorf("access request %s is not pending", r.ID)
+	}
+
+	for _, existing := range r.Approvers {
+		if existing == approver {
+			return nil
+		}
+	}
+
+	r.Approvers = append(r.Approvers, approver)
+	if len(r.Approvers) >= threshold {
+		r.State = AccessRequestApproved
+	}
+	return nil
+}
+
+// Deny transitions a PENDING request to DENIED.
+func (r *AccessRequest) Deny() error {
+	if r.State != AccessRequestPending {
+		return fmt.Errorf("access request %s is not pending", r.ID)
+	}
+	r.State = AccessRequestDenied
+	return nil
+}
+
+// MarkApplied transitions an APPROVED request to APPLIED, once the
+// operation it gates has actually run.
+func (r *AccessRequest) MarkApplied() error {
+	if r.State != AccessRequestApproved {
+		return fmt.Errorf("access request %s is not approved", r.ID)
+	}
+	r.State = AccessRequestApplied
+	return nil
+}
+
+// IsExpired reports whether the request is still pending past ExpiresAt,
+// as of now.
+func (r *AccessRequest) IsExpired(now time.Time) bool {
+	return r.State == AccessRequestPending && now.After(r.ExpiresAt)
+}
+
+// MarkExpired transitions a pending, expired request to EXPIRED.
+func (r *AccessRequest) MarkExpired() error {
+	if r.State != AccessRequestPending {
+		return fmt.Errorf("access request %s is not pending", r.ID)
+	}
+	r.State = AccessRequestExpired
+	return nil
+}
@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AppRole is a Vault AppRole-style service credential: a reusable role
+// definition (bound policies, tenant, default TTLs, CIDR binding) that one
+// or more SecretID values can be issued against, so a CI system or machine
+// client authenticates with a rotatable (RoleID, SecretID) pair instead of
+// a long-lived JWT.
+type AppRole struct {
+	// ID is the stable, immutable identifier AppRoleRepository keys
+	// storage by; SecretID.AppRoleID references this, not RoleID, so
+	// rotating RoleID never orphans already-issued SecretIDs.
+	ID string
+	// RoleID is the public identifier a caller presents at login, alongside
+	// a SecretID. It can be rotated (see RotateRoleID) without affecting
+	// ID or any issued SecretID.
+	RoleID   string
+	TenantID string
+	// BoundPolicies are the policy IDs a token minted from this role
+	// carries.
+	BoundPolicies []string
+	// SecretIDTTL and SecretIDNumUses are the defaults GenerateSecretID
+	// applies to a new SecretID unless overridden per call.
+	SecretIDTTL     time.Duration
+	SecretIDNumUses int
+	// TokenTTL bounds how long a JWT minted from a successful login stays
+	// valid.
+	TokenTTL time.Duration
+	// BoundCIDRs restricts which source IPs may log in with this role's
+	// credentials. Empty means unrestricted.
+	BoundCIDRs []string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// ResourceVersion increments on every successful write and backs
+	// optimistic-concurrency updates, matching User.ResourceVersion.
+	ResourceVersion uint64
+}
+
+// NewAppRole creates an AppRole for tenantID, bound to boundPolicies and
+// boundCIDRs, with the given SecretID/token defaults. secretIDNumUses <= 0
+// means a SecretID generated under this role is unlimited-use by default.
+func NewAppRole(tenantID string, boundPolicies []string, secretIDTTL, tokenTTL time.Duration, secretIDNumUses int, boundCIDRs []string) (*AppRole, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("approle tenant ID cannot be empty")
+	}
+	for _, cidr := range boundCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, fmt.Errorf("approle bound CIDR %q: %w", cidr, err)
+		}
+	}
+
+	now := time.Now()
+	return &AppRole{
+		ID:              uuid.NewString(),
+		RoleID:          uuid.NewString(),
+		TenantID:        tenantID,
+		BoundPolicies:   boundPolicies,
+		SecretIDTTL:     secretIDTTL,
+		SecretIDNumUses: secretIDNumUses,
+		TokenTTL:        tokenTTL,
+		BoundCIDRs:      boundCIDRs,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		ResourceVersion: 1,
+	}, nil
+}
+
+// RotateRoleID replaces RoleID with a freshly generated one, invalidating
+// the previous public identifier without disturbing ID or any SecretID
+// already issued under this role.
+func (r *AppRole) RotateRoleID() {
+	r.RoleID = uuid.NewString()
+	r.UpdatedAt = time.Now()
+}
+
+// AllowsSourceIP reports whether ip is permitted to log in with this
+// role's credentials: true unconditionally when BoundCIDRs is empty,
+// otherwise true only if ip falls within at least one bound CIDR.
+func (r *AppRole) AllowsSourceIP(ip net.IP) bool {
+	return allowsSourceIP(r.BoundCIDRs, ip)
+}
+
+// allowsSourceIP is shared by AppRole and SecretID, which both carry an
+// independent BoundCIDRs list that a login must satisfy.
+func allowsSourceIP(boundCIDRs []string, ip net.IP) bool {
+	if len(boundCIDRs) == 0 {
+		return true
+	}
+	for _, cidr := range boundCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
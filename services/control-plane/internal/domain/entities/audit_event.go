@@ -3,10 +3,13 @@ package entities
 import (
 	"errors"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // AuditEvent represents an auditable action
 type AuditEvent struct {
+	ID         string
 	Timestamp  time.Time
 	EventType  string
 	UserID     string
@@ -23,6 +26,14 @@ type AuditEvent struct {
 	UserAgent  string
 	Error      string
 	Metadata   map[string]interface{}
+
+	// PrevHash and Hash chain this event to the one logged before it, so
+	// a MerkleAuditRepository (see internal/infrastructure/persistence)
+	// can detect tampering. Both are empty until such a repository sets
+	// them in Log; a plain MemoryAuditRepository/FileAuditRepository
+	// leaves them unset.
+	PrevHash []byte
+	Hash     []byte
 }
 
 // NewAuditEvent creates a new audit event
@@ -35,6 +46,7 @@ func NewAuditEvent(eventType, action, method, path string) (*AuditEvent, error)
 	}
 
 	return &AuditEvent{
+		ID:        uuid.NewString(),
 		Timestamp: time.Now(),
 		EventType: eventType,
 		Action:    action,
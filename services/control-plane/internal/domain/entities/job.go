@@ -0,0 +1,161 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobType identifies the kind of asynchronous operation a Job runs.
+type JobType string
+
+const (
+	JobTypeSnapshot         JobType = "snapshot"
+	JobTypeReplay           JobType = "replay"
+	JobTypePolicyEvaluation JobType = "policy_evaluation"
+)
+
+// JobStatus is the lifecycle state of a Job.
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+)
+
+// Job is a durable, asynchronous unit of work dispatched by a
+// JobDispatcher, modeled on the replication-policy job schema this control
+// plane already tracks for the core event store: a type, a status, free-
+// form options, the three lifecycle timestamps, and an optional recurring
+// schedule plus the actor that triggered it.
+type Job struct {
+	ID      string
+	Type    JobType
+	Status  JobStatus
+	Options map[string]interface{}
+
+	CreationTime time.Time
+	UpdateTime   time.Time
+	// StartTime is set once the job transitions to running; nil while
+	// still pending.
+	StartTime *time.Time
+
+	// CronStr, if set, is a Go duration string (e.g. "1h", "30m") on which
+	// JobDispatcher re-triggers this job. Full cron expressions aren't
+	// supported; JobDispatcher does no cron parsing, and a fixed
+	// interval covers the same periodic-execution need.
+	CronStr string
+
+	TriggeredBy string
+	Error       string
+}
+
+// NewJob creates a new, pending Job of the given type. triggeredBy
+// identifies the user or system actor that requested it.
+func NewJob(jobType JobType, options map[string]interface{}, cronStr, triggeredBy string) (*Job, error) {
+	if err := ValidateJobType(jobType); err != nil {
+		return nil, err
+	}
+	if triggeredBy == "" {
+		return nil, errors.New("job triggered_by cannot be empty")
+	}
+	if cronStr != "" {
+		if _, err := time.ParseDuration(cronStr); err != nil {
+			return nil, fmt.Errorf("invalid cron_str %q: %w", cronStr, err)
+		}
+	}
+
+	now := time.Now()
+	return &Job{
+		ID:           uuid.NewString(),
+		Type:         jobType,
+		Status:       JobStatusPending,
+		Options:      options,
+		CreationTime: now,
+		UpdateTime:   now,
+		CronStr:      cronStr,
+		TriggeredBy:  triggeredBy,
+	}, nil
+}
+
+// ValidateJobType validates a job type.
+func ValidateJobType(jobType JobType) error {
+	switch jobType {
+	case JobTypeSnapshot, JobTypeReplay, JobTypePolicyEvaluation:
+		return nil
+	default:
+		return fmt.Errorf("invalid job type: %q", jobType)
+	}
+}
+
+// MarkRunning transitions a pending Job to running, recording StartTime.
+func (j *Job) MarkRunning() error {
+	if j.Status != JobStatusPending {
+		return fmt.Errorf("job %s is not pending", j.ID)
+	}
+	now := time.Now()
+	j.Status = JobStatusRunning
+	j.StartTime = &now
+	j.UpdateTime = now
+	return nil
+}
+
+// MarkCompleted transitions a running Job to completed.
+func (j *Job) MarkCompleted() error {
+	if j.Status != JobStatusRunning {
+		return fmt.Errorf("job %s is not running", j.ID)
+	}
+	j.Status = JobStatusCompleted
+	j.UpdateTime = time.Now()
+	return nil
+}
+
+// MarkFailed transitions a running Job to failed, recording err.
+func (j *Job) MarkFailed(err error) error {
+	if j.Status != JobStatusRunning {
+		return fmt.Errorf("job %s is not running", j.ID)
+	}
+	j.Status = JobStatusFailed
+	j.Error = err.Error()
+	j.UpdateTime = time.Now()
+	return nil
+}
+
+// Cancel transitions a pending or running Job to canceled. A Job that has
+// already reached a terminal state cannot be canceled.
+func (j *Job) Cancel() error {
+	if j.IsTerminal() {
+		return fmt.Errorf("job %s has already finished", j.ID)
+	}
+	j.Status = JobStatusCanceled
+	j.UpdateTime = time.Now()
+	return nil
+}
+
+// IsTerminal reports whether the job has reached a final state.
+func (j *Job) IsTerminal() bool {
+	switch j.Status {
+	case JobStatusCompleted, JobStatusFailed, JobStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDue reports whether CronStr's interval has elapsed since UpdateTime, as
+// of now. A Job with no CronStr is never due.
+func (j *Job) IsDue(now time.Time) bool {
+	if j.CronStr == "" {
+		return false
+	}
+	interval, err := time.ParseDuration(j.CronStr)
+	if err != nil {
+		return false
+	}
+	return !now.Before(j.UpdateTime.Add(interval))
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobExecutionStatus is the lifecycle state of a single JobExecution.
+type JobExecutionStatus string
+
+const (
+	JobExecutionPending   JobExecutionStatus = "pending"
+	JobExecutionRunning   JobExecutionStatus = "running"
+	JobExecutionSucceeded JobExecutionStatus = "succeeded"
+	JobExecutionFailed    JobExecutionStatus = "failed"
+)
+
+// JobExecution is a single run of a Job, recorded separately from the Job
+// itself so a recurring Job (one whose CronStr re-triggers it) has a full
+// execution history rather than just its most recent outcome. It captures
+// a shell-job-like result: stdout/stderr text and an exit code, plus the
+// OpenTelemetry trace a caller can open in Jaeger to inspect the run.
+type JobExecution struct {
+	ID     string
+	JobID  string
+	Status JobExecutionStatus
+
+	Stdout   string
+	Stderr   string
+	ExitCode int
+
+	// TraceID is the hex-encoded OpenTelemetry trace ID of the span
+	// JobDispatcher opened for this execution, empty if tracing is
+	// disabled.
+	TraceID string
+
+	CreationTime time.Time
+	UpdateTime   time.Time
+	StartTime    *time.Time
+	EndTime      *time.Time
+}
+
+// NewJobExecution creates a new, pending JobExecution for jobID's current
+// run.
+func NewJobExecution(jobID string) *JobExecution {
+	now := time.Now()
+	return &JobExecution{
+		ID:           uuid.NewString(),
+		JobID:        jobID,
+		Status:       JobExecutionPending,
+		CreationTime: now,
+		UpdateTime:   now,
+	}
+}
+
+// MarkRunning transitions a pending execution to running, recording
+// StartTime.
+func (e *JobExecution) MarkRunning() error {
+	if e.Status != JobExecutionPending {
+		return fmt.Errorf("job execution %s is not pending", e.ID)
+	}
+	now := time.Now()
+	e.Status = JobExecutionRunning
+	e.StartTime = &now
+	e.UpdateTime = now
+	return nil
+}
+
+// MarkSucceeded transitions a running execution to succeeded, recording
+// stdout and EndTime.
+func (e *JobExecution) MarkSucceeded(stdout string) error {
+	if e.Status != JobExecutionRunning {
+		return fmt.Errorf("job execution %s is not running", e.ID)
+	}
+	now := time.Now()
+	e.Status = JobExecutionSucceeded
+	e.Stdout = stdout
+	e.ExitCode = 0
+	e.EndTime = &now
+	e.UpdateTime = now
+	return nil
+}
+
+// MarkFailed transitions a running execution to failed, recording stderr,
+// a non-zero exit code, and EndTime.
+func (e *JobExecution) MarkFailed(stderr string, exitCode int) error {
+	if e.Status != JobExecutionRunning {
+		return fmt.Errorf("job execution %s is not running", e.ID)
+	}
+	if exitCode == 0 {
+		exitCode = 1
+	}
+	now := time.Now()
+	e.Status = JobExecutionFailed
+	e.Stderr = stderr
+	e.ExitCode = exitCode
+	e.EndTime = &now
+	e.UpdateTime = now
+	return nil
+}
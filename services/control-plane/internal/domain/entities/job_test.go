@@ -0,0 +1,145 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewJob(t *testing.T) {
+	tests := []struct {
+		name        string
+		jobType     JobType
+		cronStr     string
+		triggeredBy string
+		wantErr     bool
+	}{
+		{
+			name:        "Valid snapshot job",
+			jobType:     JobTypeSnapshot,
+			triggeredBy: "api",
+			wantErr:     false,
+		},
+		{
+			name:        "Valid replay job with schedule",
+			jobType:     JobTypeReplay,
+			cronStr:     "1h",
+			triggeredBy: "api",
+			wantErr:     false,
+		},
+		{
+			name:        "Invalid job type",
+			jobType:     JobType("bogus"),
+			triggeredBy: "api",
+			wantErr:     true,
+		},
+		{
+			name:        "Empty triggered by",
+			jobType:     JobTypeSnapshot,
+			triggeredBy: "",
+			wantErr:     true,
+		},
+		{
+			name:        "Invalid cron_str",
+			jobType:     JobTypeSnapshot,
+			cronStr:     "not-a-duration",
+			triggeredBy: "api",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := NewJob(tt.jobType, nil, tt.cronStr, tt.triggeredBy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewJob() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if job.Status != JobStatusPending {
+					t.Errorf("Job.Status = %v, want %v", job.Status, JobStatusPending)
+				}
+				if job.ID == "" {
+					t.Error("Job.ID should be set")
+				}
+			}
+		})
+	}
+}
+
+func TestJob_Lifecycle(t *testing.T) {
+	job, _ := NewJob(JobTypeSnapshot, nil, "", "api")
+
+	if err := job.MarkCompleted(); err == nil {
+		t.Error("should not be able to complete a pending job")
+	}
+
+	if err := job.MarkRunning(); err != nil {
+		t.Fatalf("MarkRunning() failed: %v", err)
+	}
+	if job.Status != JobStatusRunning {
+		t.Errorf("Job.Status = %v, want %v", job.Status, JobStatusRunning)
+	}
+	if job.StartTime == nil {
+		t.Fatal("StartTime should be set once running")
+	}
+
+	if err := job.MarkRunning(); err == nil {
+		t.Error("should not be able to re-start a running job")
+	}
+
+	if err := job.MarkCompleted(); err != nil {
+		t.Fatalf("MarkCompleted() failed: %v", err)
+	}
+	if job.Status != JobStatusCompleted {
+		t.Errorf("Job.Status = %v, want %v", job.Status, JobStatusCompleted)
+	}
+	if !job.IsTerminal() {
+		t.Error("completed job should be terminal")
+	}
+}
+
+func TestJob_MarkFailed(t *testing.T) {
+	job, _ := NewJob(JobTypeReplay, nil, "", "api")
+	_ = job.MarkRunning()
+
+	if err := job.MarkFailed(errors.New("replay source unreachable")); err != nil {
+		t.Fatalf("MarkFailed() failed: %v", err)
+	}
+	if job.Status != JobStatusFailed {
+		t.Errorf("Job.Status = %v, want %v", job.Status, JobStatusFailed)
+	}
+	if job.Error != "replay source unreachable" {
+		t.Errorf("Job.Error = %q, want %q", job.Error, "replay source unreachable")
+	}
+}
+
+func TestJob_Cancel(t *testing.T) {
+	job, _ := NewJob(JobTypeSnapshot, nil, "", "api")
+	if err := job.Cancel(); err != nil {
+		t.Fatalf("Cancel() failed: %v", err)
+	}
+	if job.Status != JobStatusCanceled {
+		t.Errorf("Job.Status = %v, want %v", job.Status, JobStatusCanceled)
+	}
+
+	if err := job.Cancel(); err == nil {
+		t.Error("should not be able to cancel an already-terminal job")
+	}
+}
+
+func TestJob_IsDue(t *testing.T) {
+	job, _ := NewJob(JobTypeSnapshot, nil, "1h", "api")
+
+	if job.IsDue(time.Now()) {
+		t.Error("freshly created job should not be due immediately")
+	}
+	if !job.IsDue(job.UpdateTime.Add(time.Hour + time.Second)) {
+		t.Error("job should be due once its interval has elapsed")
+	}
+
+	unscheduled, _ := NewJob(JobTypeSnapshot, nil, "", "api")
+	if unscheduled.IsDue(time.Now().Add(24 * time.Hour)) {
+		t.Error("job with no cron_str should never be due")
+	}
+}
@@ -31,6 +31,47 @@ type Policy struct {
 	Conditions  []PolicyCondition
 	Priority    int  // Higher priority = evaluated first
 	Enabled     bool
+
+	// Expression is an optional boolean expression, evaluated by
+	// ExpressionLanguage, ANDed together with Conditions. Conditions alone
+	// only cover eq/ne/contains and are kept as a compatibility shim;
+	// Expression is where ABAC-style policies should live, e.g.
+	// `attributes.role == "Admin" && attributes.record_count < 10000`.
+	Expression         string
+	ExpressionLanguage ExpressionLanguage
+
+	// Obligations are copied onto the Decision whenever this policy
+	// matches, whether or not it's the one that decides the outcome of a
+	// PolicySet evaluation, e.g. {"require_mfa": true}. A PEP (the
+	// enforcement middleware, a sidecar, ...) MUST carry these out; a PEP
+	// that can't should treat the decision as ActionDeny.
+	Obligations map[string]interface{}
+
+	// Advice, like Obligations, is copied onto the Decision from every
+	// matched policy, but is optional: a PEP that can't carry it out may
+	// still honor the decision, e.g. {"log_to_siem": true}.
+	Advice map[string]interface{}
+
+	// Engine selects which PolicyEvaluator decides this policy:
+	// PolicyEngineBuiltin (the default) evaluates Conditions and
+	// Expression as above; PolicyEngineRego instead compiles ModuleSource
+	// and evaluates it against a PolicyInput document, bypassing
+	// Conditions and Expression entirely.
+	Engine PolicyEngine
+
+	// ModuleSource holds the Rego module source for Engine ==
+	// PolicyEngineRego. It is compiled once, the first time the policy is
+	// evaluated, and cached by ID and ResourceVersion (see CompilePolicyModule for validating
+	// it eagerly at Save/Update time).
+	ModuleSource string
+
+	// ResourceVersion increments on every GuaranteedUpdate and on every
+	// UpdatePolicyUseCase write; a plain PolicyRepository.Update stores it as
+	// given. It backs optimistic-concurrency updates (see
+	// PolicyRepository.GuaranteedUpdate).
+	// A caller that read version N may only overwrite the record while it
+	// is still at N.
+	ResourceVersion uint64
 }
 
 // NewPolicy creates a new policy with validation
@@ -46,14 +87,15 @@ func NewPolicy(id, name, description, resource string, action PolicyAction, prio
 	}
 
 	return &Policy{
-		ID:          id,
-		Name:        name,
-		Description: description,
-		Resource:    resource,
-		Action:      action,
-		Conditions:  []PolicyCondition{},
-		Priority:    priority,
-		Enabled:     true,
+		ID:              id,
+		Name:            name,
+		Description:     description,
+		Resource:        resource,
+		Action:          action,
+		Conditions:      []PolicyCondition{},
+		Priority:        priority,
+		Enabled:         true,
+		ResourceVersion: 1,
 	}, nil
 }
 
@@ -110,8 +152,26 @@ func (p *Policy) Disable() {
 	p.Enabled = false
 }
 
-// Evaluate evaluates a policy against given attributes
+// Evaluate evaluates a policy against given attributes, dispatching to the
+// PolicyEvaluator registered for p.Engine (PolicyEngineBuiltin unless set).
 func (p *Policy) Evaluate(attributes map[string]interface{}) (bool, error) {
+	engine := p.Engine
+	if engine == "" {
+		engine = PolicyEngineBuiltin
+	}
+
+	evaluator, ok := policyEvaluators[engine]
+	if !ok {
+		return false, fmt.Errorf("no evaluator registered for policy engine %q", engine)
+	}
+
+	return evaluator.Evaluate(p, PolicyInput{RequestAttrs: attributes})
+}
+
+// evaluateBuiltin is the PolicyEngineBuiltin PolicyEvaluator: Conditions
+// (the eq/ne/contains compatibility shim) and Expression, if set, must
+// both match for the policy to apply.
+func (p *Policy) evaluateBuiltin(attributes map[string]interface{}) (bool, error) {
 	if !p.Enabled {
 		return false, nil
 	}
@@ -127,9 +187,55 @@ func (p *Policy) Evaluate(attributes map[string]interface{}) (bool, error) {
 		}
 	}
 
+	if p.Expression != "" {
+		matches, err := p.evaluateExpression(attributes)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
+// evaluateExpression compiles (on first use, then cached) and evaluates
+// p.Expression against attributes using the evaluator registered for
+// p.ExpressionLanguage (ExpressionLanguageCEL if unset).
+func (p *Policy) evaluateExpression(attributes map[string]interface{}) (bool, error) {
+	lang := p.ExpressionLanguage
+	if lang == "" {
+		lang = ExpressionLanguageCEL
+	}
+
+	evaluator, ok := expressionEvaluators[lang]
+	if !ok {
+		return false, fmt.Errorf("no evaluator registered for expression language %q", lang)
+	}
+
+	// Keyed by ID + ResourceVersion, not just ID, so an Update that edits
+	// Expression (bumping ResourceVersion) recompiles instead of silently
+	// reusing the previous version's compiled program.
+	cacheKey := p.expressionCacheKey()
+	compiled, ok := policyExpressionCache.get(cacheKey)
+	if !ok {
+		var err error
+		compiled, err = evaluator.Compile(p.Expression)
+		if err != nil {
+			return false, fmt.Errorf("policy %q: %w", p.ID, err)
+		}
+		policyExpressionCache.set(cacheKey, compiled)
+	}
+
+	return evaluator.Evaluate(compiled, attributes)
+}
+
+// expressionCacheKey is p's key into policyExpressionCache.
+func (p *Policy) expressionCacheKey() string {
+	return fmt.Sprintf("%s@%d", p.ID, p.ResourceVersion)
+}
+
 // evaluateCondition evaluates a single condition
 func (p *Policy) evaluateCondition(condition PolicyCondition, attributes map[string]interface{}) (bool, error) {
 	attrValue, exists := attributes[condition.Field]
@@ -0,0 +1,161 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// PolicyEngine identifies which PolicyEvaluator decides a Policy.
+type PolicyEngine string
+
+const (
+	// PolicyEngineBuiltin evaluates Conditions and Expression, as Policy
+	// always did before Engine existed. It's the default for a zero-value
+	// Engine.
+	PolicyEngineBuiltin PolicyEngine = "builtin"
+
+	// PolicyEngineRego compiles and evaluates Policy.ModuleSource with
+	// Open Policy Agent, bypassing Conditions and Expression entirely.
+	PolicyEngineRego PolicyEngine = "rego"
+)
+
+// PolicyInput is the input document a PolicyEvaluator decides a Policy
+// against. It wraps the same flat attribute map Policy.Evaluate has always
+// taken; the builtin evaluator matches Conditions/Expression against it
+// directly, while the Rego evaluator reprojects it into the {user, tenant,
+// resource, operation, request_attrs} shape rego modules in this repo are
+// written against (see regoInputDocument), reading "user_id"/"tenant_id"/
+// "operation" by the same convention the rest of this codebase already
+// uses for those attributes (e.g. runPolicyEvaluationJob).
+type PolicyInput struct {
+	RequestAttrs map[string]interface{}
+}
+
+// PolicyEvaluator decides whether policy matches input. PolicyEngineBuiltin
+// and PolicyEngineRego each register one in policyEvaluators.
+type PolicyEvaluator interface {
+	Evaluate(policy *Policy, input PolicyInput) (bool, error)
+}
+
+// builtinPolicyEvaluator implements PolicyEvaluator via Policy.evaluateBuiltin.
+type builtinPolicyEvaluator struct{}
+
+func (builtinPolicyEvaluator) Evaluate(policy *Policy, input PolicyInput) (bool, error) {
+	return policy.evaluateBuiltin(input.RequestAttrs)
+}
+
+// regoPolicyEvaluator implements PolicyEvaluator by compiling (on first use,
+// then cached) and evaluating policy.ModuleSource's "data.policy.allow"
+// rule against input.
+type regoPolicyEvaluator struct{}
+
+func (regoPolicyEvaluator) Evaluate(policy *Policy, input PolicyInput) (bool, error) {
+	if !policy.Enabled {
+		return false, nil
+	}
+
+	query, err := compiledRegoQuery(policy)
+	if err != nil {
+		return false, err
+	}
+
+	results, err := query.Eval(context.Background(), rego.EvalInput(regoInputDocument(policy, input)))
+	if err != nil {
+		return false, fmt.Errorf("policy %q: evaluate rego module: %w", policy.ID, err)
+	}
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return false, nil
+	}
+
+	allowed, ok := results[0].Expressions[0].Value.(bool)
+	if !ok {
+		return false, fmt.Errorf("policy %q: rego module's data.policy.allow must evaluate to a bool, got %T", policy.ID, results[0].Expressions[0].Value)
+	}
+	return allowed, nil
+}
+
+// regoInputDocument builds the OPA input document for policy, per the
+// {user, tenant, resource, operation, request_attrs} shape rego modules in
+// this repo are written against. user/tenant/operation are pulled from
+// the conventional "user_id"/"tenant_id"/"operation" attribute keys the
+// rest of this codebase already uses (e.g. runPolicyEvaluationJob).
+func regoInputDocument(policy *Policy, input PolicyInput) map[string]interface{} {
+	return map[string]interface{}{
+		"user":          input.RequestAttrs["user_id"],
+		"tenant":        input.RequestAttrs["tenant_id"],
+		"resource":      policy.Resource,
+		"operation":     input.RequestAttrs["operation"],
+		"request_attrs": input.RequestAttrs,
+	}
+}
+
+// policyEvaluators maps each PolicyEngine to its PolicyEvaluator.
+var policyEvaluators = map[PolicyEngine]PolicyEvaluator{
+	PolicyEngineBuiltin: builtinPolicyEvaluator{},
+	PolicyEngineRego:    regoPolicyEvaluator{},
+}
+
+// compiledRegoCache holds prepared rego queries keyed by policy ID and
+// ResourceVersion, so a Rego-engine Policy is compiled once per version
+// rather than on every evaluation.
+type compiledRegoCache struct {
+	mu      sync.RWMutex
+	queries map[string]rego.PreparedEvalQuery
+}
+
+func (c *compiledRegoCache) get(policyID string) (rego.PreparedEvalQuery, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	query, ok := c.queries[policyID]
+	return query, ok
+}
+
+func (c *compiledRegoCache) set(policyID string, query rego.PreparedEvalQuery) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries[policyID] = query
+}
+
+var policyRegoCache = &compiledRegoCache{queries: make(map[string]rego.PreparedEvalQuery)}
+
+// compiledRegoQuery returns policy.ModuleSource's prepared "data.policy.allow"
+// query, compiling and caching it on first use. It's keyed by ID +
+// ResourceVersion, not just ID, so an Update that edits ModuleSource
+// (bumping ResourceVersion) recompiles instead of silently reusing the
+// previous version's compiled query.
+func compiledRegoQuery(policy *Policy) (rego.PreparedEvalQuery, error) {
+	key := policy.expressionCacheKey()
+	if query, ok := policyRegoCache.get(key); ok {
+		return query, nil
+	}
+
+	query, err := rego.New(
+		rego.Query("data.policy.allow"),
+		rego.Module(policy.ID+".rego", policy.ModuleSource),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		return rego.PreparedEvalQuery{}, fmt.Errorf("policy %q: compile rego module: %w", policy.ID, err)
+	}
+
+	policyRegoCache.set(key, query)
+	return query, nil
+}
+
+// CompilePolicyModule validates policy's evaluator-specific source eagerly,
+// so a bad Rego module is caught at Save/Update time rather than on the
+// first evaluation. PolicyEngineBuiltin policies are always valid here; any
+// ModuleSource they carry is not compiled or checked. compiledRegoQuery is
+// keyed by ID + ResourceVersion, so an Update that edits ModuleSource
+// naturally recompiles on its next evaluation rather than reusing a stale
+// cached query.
+func CompilePolicyModule(policy *Policy) error {
+	if policy.Engine != PolicyEngineRego {
+		return nil
+	}
+
+	_, err := compiledRegoQuery(policy)
+	return err
+}
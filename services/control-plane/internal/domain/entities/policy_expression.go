@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// ExpressionLanguage identifies which engine should evaluate Policy.Expression.
+type ExpressionLanguage string
+
+const (
+	ExpressionLanguageCEL  ExpressionLanguage = "cel"
+	ExpressionLanguageRego ExpressionLanguage = "rego"
+)
+
+// expressionEvaluator compiles and evaluates a Policy.Expression against
+// an attribute map. Implementations do not cache; Policy.evaluateExpression
+// caches their compiled output in policyExpressionCache, keyed by policy ID
+// and ResourceVersion.
+type expressionEvaluator interface {
+	Compile(expr string) (interface{}, error)
+	Evaluate(compiled interface{}, attributes map[string]interface{}) (bool, error)
+}
+
+// celExpressionEvaluator implements expressionEvaluator using google/cel-go.
+// Expressions see the attribute map as `attributes`, e.g.
+//
+//	attributes.role == "Admin" && attributes.record_count < 10000
+type celExpressionEvaluator struct {
+	env *cel.Env
+}
+
+func newCELExpressionEvaluator() (*celExpressionEvaluator, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("attributes", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	return &celExpressionEvaluator{env: env}, nil
+}
+
+// Compile parses and type-checks a CEL expression, returning a cel.Program
+// ready for repeated evaluation.
+func (e *celExpressionEvaluator) Compile(expr string) (interface{}, error) {
+	ast, issues := e.env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression: %w", issues.Err())
+	}
+
+	prg, err := e.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build CEL program: %w", err)
+	}
+
+	return prg, nil
+}
+
+// Evaluate runs a compiled cel.Program against the attribute map.
+func (e *celExpressionEvaluator) Evaluate(compiled interface{}, attributes map[string]interface{}) (bool, error) {
+	prg, ok := compiled.(cel.Program)
+	if !ok {
+		return false, fmt.Errorf("invalid compiled expression type %T", compiled)
+	}
+
+	out, _, err := prg.Eval(map[string]interface{}{
+		"attributes": attributes,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate CEL expression: %w", err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("CEL expression must evaluate to a bool, got %T", out.Value())
+	}
+
+	return result, nil
+}
+
+// expressionEvaluators maps each supported ExpressionLanguage to its
+// expressionEvaluator. Rego is accepted as a valid Policy.ExpressionLanguage
+// value so callers can plan for it, but only CEL has a working
+// implementation today.
+var expressionEvaluators = map[ExpressionLanguage]expressionEvaluator{}
+
+func init() {
+	evaluator, err := newCELExpressionEvaluator()
+	if err != nil {
+		panic(fmt.Sprintf("entities: failed to initialize CEL evaluator: %v", err))
+	}
+	expressionEvaluators[ExpressionLanguageCEL] = evaluator
+}
+
+// compiledExpressionCache holds compiled expressions keyed by policy ID and
+// ResourceVersion (see Policy.expressionCacheKey), so
+// Policy.Evaluate never re-parses an expression on the hot path.
+type compiledExpressionCache struct {
+	mu       sync.RWMutex
+	compiled map[string]interface{}
+}
+
+func (c *compiledExpressionCache) get(policyID string) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	compiled, ok := c.compiled[policyID]
+	return compiled, ok
+}
+
+func (c *compiledExpressionCache) set(policyID string, compiled interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.compiled[policyID] = compiled
+}
+
+var policyExpressionCache = &compiledExpressionCache{compiled: make(map[string]interface{})}
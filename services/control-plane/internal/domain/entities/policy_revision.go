@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// PolicyChangeType identifies why a PolicyRevision was created.
+type PolicyChangeType string
+
+const (
+	PolicyChangeCreate   PolicyChangeType = "create"
+	PolicyChangeUpdate   PolicyChangeType = "update"
+	PolicyChangeDelete   PolicyChangeType = "delete"
+	PolicyChangeRollback PolicyChangeType = "rollback"
+)
+
+// PolicyRevision is an immutable snapshot of a Policy as it existed after a
+// single mutation. Revisions are numbered sequentially per policy starting
+// at 1, so (PolicyID, Revision) is a stable identifier for "what did this
+// policy look like before".
+type PolicyRevision struct {
+	PolicyID   string
+	Revision   int
+	Policy     Policy
+	ChangeType PolicyChangeType
+	Actor      string
+	Comment    string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,201 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Decision is the outcome of evaluating a PolicySet against a set of
+// attributes: which policy (if any) decided the outcome, what effect it
+// carries, and why. Obligations and Advice are collected from every
+// matched policy, not just the one that decided the outcome, since a
+// lower-priority allow policy's "mask this field" obligation still
+// applies even when a higher-priority policy is the one that denies.
+type Decision struct {
+	Effect          PolicyAction
+	MatchedPolicyID string
+	Obligations     []PolicyObligation
+	Advice          []PolicyObligation
+	Reasons         []string
+}
+
+// PolicyObligation pairs a matched policy's ID with the Obligations (or
+// Advice) bag it contributed to a Decision, since more than one matched
+// policy can contribute to the same Decision.
+type PolicyObligation struct {
+	PolicyID string
+	Values   map[string]interface{}
+}
+
+// CombiningAlgorithm selects how PolicySet.Decide resolves multiple
+// matched policies into one Decision.
+type CombiningAlgorithm string
+
+const (
+	// CombineDenyOverrides is the default, and PolicySet's original,
+	// hardcoded behavior before CombiningAlgorithm existed: any matching
+	// deny policy wins outright regardless of priority; absent a deny,
+	// the highest-priority matching warn policy decides; absent either,
+	// the default is allow.
+	CombineDenyOverrides CombiningAlgorithm = "deny-overrides"
+
+	// CombinePermitOverrides resolves any matching allow policy over a
+	// matching deny; absent both, the highest-priority matching warn
+	// policy decides; absent all three, the default is allow.
+	CombinePermitOverrides CombiningAlgorithm = "permit-overrides"
+
+	// CombineFirstApplicable returns the action of the first matching
+	// policy in priority order, whatever that action is, instead of
+	// letting a later deny or allow override it.
+	CombineFirstApplicable CombiningAlgorithm = "first-applicable"
+
+	// CombineDenyUnlessPermit returns allow if any policy matches with
+	// ActionAllow, and deny otherwise. Unlike the other algorithms, it
+	// never falls back to a default allow when nothing matches.
+	CombineDenyUnlessPermit CombiningAlgorithm = "deny-unless-permit"
+)
+
+// PolicySet is a collection of policies evaluated together in priority
+// order and combined by a CombiningAlgorithm. Under the default,
+// CombineDenyOverrides, precedence is deny-over-warn-over-allow: any
+// matching deny policy wins outright regardless of priority; absent a
+// deny, the highest-priority matching warn policy decides; absent either,
+// the default is allow.
+//
+// A PolicySet is safe for concurrent use. Reload swaps the policy slice
+// under a write lock, so a single long-lived PolicySet (e.g. the one given
+// to PolicyEnforcementMiddleware) can pick up policy CRUD changes without
+// the middleware needing to be re-registered.
+type PolicySet struct {
+	mu       sync.RWMutex
+	policies []*Policy
+}
+
+// NewPolicySet builds a PolicySet from policies, sorted by priority
+// (highest first) once up front so Decide doesn't re-sort on every call.
+func NewPolicySet(policies []*Policy) *PolicySet {
+	set := &PolicySet{}
+	set.Reload(policies)
+	return set
+}
+
+// Reload replaces the set's policies, re-sorting by priority (highest
+// first). Safe to call while Decide is running concurrently.
+func (s *PolicySet) Reload(policies []*Policy) {
+	sorted := make([]*Policy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority > sorted[j].Priority
+	})
+
+	s.mu.Lock()
+	s.policies = sorted
+	s.mu.Unlock()
+}
+
+// Decide evaluates every enabled policy in the set against attributes and
+// combines the matches into one Decision per algorithm (CombineDenyOverrides
+// if empty). Every matched policy's Obligations and Advice are collected
+// into the Decision regardless of which policy decides the outcome. ctx is
+// accepted, though not otherwise used today, so callers (including the Gin
+// middleware layer) can thread request cancellation and tracing through to
+// future evaluators, such as a Rego engine that fetches external data.
+func (s *PolicySet) Decide(ctx context.Context, attributes map[string]interface{}, algorithm CombiningAlgorithm) Decision {
+	if algorithm == "" {
+		algorithm = CombineDenyOverrides
+	}
+
+	s.mu.RLock()
+	policies := s.policies
+	s.mu.RUnlock()
+
+	var reasons []string
+	var obligations, advice []PolicyObligation
+	var denyMatch, allowMatch, warnMatch *Policy
+
+	for _, policy := range policies {
+		if !policy.Enabled {
+			continue
+		}
+
+		matched, err := policy.Evaluate(attributes)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("policy %s: evaluation error: %v", policy.ID, err))
+			continue
+		}
+		if !matched {
+			continue
+		}
+
+		if len(policy.Obligations) > 0 {
+			obligations = append(obligations, PolicyObligation{PolicyID: policy.ID, Values: policy.Obligations})
+		}
+		if len(policy.Advice) > 0 {
+			advice = append(advice, PolicyObligation{PolicyID: policy.ID, Values: policy.Advice})
+		}
+
+		switch policy.Action {
+		case ActionDeny:
+			if denyMatch == nil {
+				denyMatch = policy
+			}
+			reasons = append(reasons, "deny: "+policy.Name)
+		case ActionAllow:
+			if allowMatch == nil {
+				allowMatch = policy
+			}
+			reasons = append(reasons, "allow: "+policy.Name)
+		case ActionWarn:
+			if warnMatch == nil {
+				warnMatch = policy
+			}
+			reasons = append(reasons, "warn: "+policy.Name)
+		}
+
+		if algorithm == CombineFirstApplicable {
+			return Decision{
+				Effect:          policy.Action,
+				MatchedPolicyID: policy.ID,
+				Obligations:     obligations,
+				Advice:          advice,
+				Reasons:         reasons,
+			}
+		}
+	}
+
+	switch algorithm {
+	case CombinePermitOverrides:
+		if allowMatch != nil {
+			return Decision{Effect: ActionAllow, MatchedPolicyID: allowMatch.ID, Obligations: obligations, Advice: advice, Reasons: reasons}
+		}
+		if denyMatch != nil {
+			return Decision{Effect: ActionDeny, MatchedPolicyID: denyMatch.ID, Obligations: obligations, Advice: advice, Reasons: reasons}
+		}
+		if warnMatch != nil {
+			return Decision{Effect: ActionWarn, MatchedPolicyID: warnMatch.ID, Obligations: obligations, Advice: advice, Reasons: reasons}
+		}
+		return Decision{Effect: ActionAllow, Obligations: obligations, Advice: advice, Reasons: append(reasons, "no deny, allow, or warn policy matched, default allow")}
+
+	case CombineDenyUnlessPermit:
+		if allowMatch != nil {
+			return Decision{Effect: ActionAllow, MatchedPolicyID: allowMatch.ID, Obligations: obligations, Advice: advice, Reasons: reasons}
+		}
+		return Decision{Effect: ActionDeny, Obligations: obligations, Advice: advice, Reasons: append(reasons, "deny-unless-permit: no allow policy matched")}
+
+	case CombineFirstApplicable:
+		// No policy matched at all; CombineFirstApplicable's matching case
+		// above already returned for any match.
+		return Decision{Effect: ActionAllow, Obligations: obligations, Advice: advice, Reasons: append(reasons, "no policy matched, default allow")}
+
+	default: // CombineDenyOverrides
+		if denyMatch != nil {
+			return Decision{Effect: ActionDeny, MatchedPolicyID: denyMatch.ID, Obligations: obligations, Advice: advice, Reasons: reasons}
+		}
+		if warnMatch != nil {
+			return Decision{Effect: ActionWarn, MatchedPolicyID: warnMatch.ID, Obligations: obligations, Advice: advice, Reasons: reasons}
+		}
+		return Decision{Effect: ActionAllow, Obligations: obligations, Advice: advice, Reasons: append(reasons, "no deny or warn policy matched, default allow")}
+	}
+}
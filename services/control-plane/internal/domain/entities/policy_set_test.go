@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPolicySet_Decide_DenyAlwaysWins(t *testing.T) {
+	allow, _ := NewPolicy("allow-all", "Allow All", "", "tenant", ActionAllow, 100)
+	deny, _ := NewPolicy("deny-default", "Deny Default", "", "tenant", ActionDeny, 10)
+	_ = deny.AddCondition("tenant_id", "eq", "default")
+
+	set := NewPolicySet([]*Policy{allow, deny})
+
+	decision := set.Decide(context.Background(), map[string]interface{}{"tenant_id": "default"}, CombineDenyOverrides)
+	if decision.Effect != ActionDeny {
+		t.Errorf("Effect = %v, want %v", decision.Effect, ActionDeny)
+	}
+	if decision.MatchedPolicyID != "deny-default" {
+		t.Errorf("MatchedPolicyID = %v, want deny-default", decision.MatchedPolicyID)
+	}
+}
+
+func TestPolicySet_Decide_WarnWhenNoDenyMatches(t *testing.T) {
+	warn, _ := NewPolicy("warn-large", "Warn Large", "", "operation", ActionWarn, 50)
+	_ = warn.AddCondition("record_count", "eq", "20000")
+
+	set := NewPolicySet([]*Policy{warn})
+
+	decision := set.Decide(context.Background(), map[string]interface{}{"record_count": "20000"}, CombineDenyOverrides)
+	if decision.Effect != ActionWarn {
+		t.Errorf("Effect = %v, want %v", decision.Effect, ActionWarn)
+	}
+	if decision.MatchedPolicyID != "warn-large" {
+		t.Errorf("MatchedPolicyID = %v, want warn-large", decision.MatchedPolicyID)
+	}
+}
+
+func TestPolicySet_Decide_DefaultAllow(t *testing.T) {
+	deny, _ := NewPolicy("deny-default", "Deny Default", "", "tenant", ActionDeny, 10)
+	_ = deny.AddCondition("tenant_id", "eq", "default")
+
+	set := NewPolicySet([]*Policy{deny})
+
+	decision := set.Decide(context.Background(), map[string]interface{}{"tenant_id": "tenant-1"}, CombineDenyOverrides)
+	if decision.Effect != ActionAllow {
+		t.Errorf("Effect = %v, want %v", decision.Effect, ActionAllow)
+	}
+	if decision.MatchedPolicyID != "" {
+		t.Errorf("MatchedPolicyID = %v, want empty", decision.MatchedPolicyID)
+	}
+}
+
+func TestPolicySet_Decide_ObligationsCarryOverFromMatchedPolicy(t *testing.T) {
+	deny, _ := NewPolicy("deny-default", "Deny Default", "", "tenant", ActionDeny, 10)
+	_ = deny.AddCondition("tenant_id", "eq", "default")
+	deny.Obligations = map[string]interface{}{"require_mfa": true}
+
+	set := NewPolicySet([]*Policy{deny})
+
+	decision := set.Decide(context.Background(), map[string]interface{}{"tenant_id": "default"}, CombineDenyOverrides)
+	if len(decision.Obligations) != 1 || decision.Obligations[0].PolicyID != "deny-default" || decision.Obligations[0].Values["require_mfa"] != true {
+		t.Errorf("Obligations = %v, want one entry from deny-default with require_mfa=true", decision.Obligations)
+	}
+}
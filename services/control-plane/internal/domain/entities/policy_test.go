@@ -99,3 +99,60 @@ func TestPolicy_Disabled(t *testing.T) {
 		t.Error("Disabled policy should not match")
 	}
 }
+
+func TestPolicy_Expression(t *testing.T) {
+	policy, _ := NewPolicy("policy-expr", "Test", "Description", "operation", ActionDeny, 100)
+	policy.Expression = `attributes.role != "Admin" && attributes.record_count > 10000`
+
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		want       bool
+	}{
+		{
+			name: "Expression matches",
+			attributes: map[string]interface{}{
+				"role":         "Developer",
+				"record_count": 20000,
+			},
+			want: true,
+		},
+		{
+			name: "Expression does not match",
+			attributes: map[string]interface{}{
+				"role":         "Admin",
+				"record_count": 20000,
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := policy.Evaluate(tt.attributes)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if matches != tt.want {
+				t.Errorf("Evaluate() = %v, want %v", matches, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicy_ExpressionAndConditionsBothRequired(t *testing.T) {
+	policy, _ := NewPolicy("policy-expr-cond", "Test", "Description", "tenant", ActionDeny, 100)
+	_ = policy.AddCondition("tenant_id", "eq", "default")
+	policy.Expression = `attributes.operation == "delete"`
+
+	matches, err := policy.Evaluate(map[string]interface{}{
+		"tenant_id": "default",
+		"operation": "read",
+	})
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if matches {
+		t.Error("Evaluate() should require both Conditions and Expression to match")
+	}
+}
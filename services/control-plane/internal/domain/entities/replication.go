@@ -0,0 +1,190 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReplicationSourcePolicies is the only source resource this control plane
+// currently replicates: the set of policies a PolicyRepository holds.
+const ReplicationSourcePolicies = "policies"
+
+// ReplicationTarget is a peer control-plane instance a ReplicationPolicy can
+// push policy bundles to, modeled on Harbor's replication target: a named
+// endpoint, the credential presented when pushing to it, and whether it's
+// currently eligible for replication.
+type ReplicationTarget struct {
+	ID          string
+	Name        string
+	URL         string
+	Credentials string // bearer token presented to the peer's ingest endpoint
+	Enabled     bool
+
+	CreationTime time.Time
+	UpdateTime   time.Time
+}
+
+// NewReplicationTarget creates a new, enabled ReplicationTarget.
+func NewReplicationTarget(name, url, credentials string) (*ReplicationTarget, error) {
+	if name == "" {
+		return nil, errors.New("replication target name cannot be empty")
+	}
+	if url == "" {
+		return nil, errors.New("replication target url cannot be empty")
+	}
+
+	now := time.Now()
+	return &ReplicationTarget{
+		ID:           uuid.NewString(),
+		Name:         name,
+		URL:          url,
+		Credentials:  credentials,
+		Enabled:      true,
+		CreationTime: now,
+		UpdateTime:   now,
+	}, nil
+}
+
+// ReplicationPolicy binds a source resource to a ReplicationTarget, on an
+// optional cron schedule, modeled on Harbor's replication_policy. Priority
+// decides which of two ReplicationPolicies pushing to the same target wins
+// when their bundles conflict (see replication.ResolveConflict): the
+// higher Priority, and then the newer Version, is applied.
+type ReplicationPolicy struct {
+	ID             string
+	SourceResource string
+	TargetID       string
+	// CronStr, if set, is a standard 5-field cron expression on which
+	// Scheduler re-triggers this policy. Unlike entities.Job's CronStr,
+	// this is real cron rather than a duration string, since this
+	// subsystem's scheduler has a cron-parsing dependency (robfig/cron)
+	// the job dispatcher doesn't.
+	CronStr     string
+	Priority    int
+	TriggeredBy string
+	Enabled     bool
+
+	CreationTime time.Time
+	UpdateTime   time.Time
+}
+
+// NewReplicationPolicy creates a new, enabled ReplicationPolicy replicating
+// ReplicationSourcePolicies to targetID. triggeredBy identifies the user or
+// system actor that created it.
+func NewReplicationPolicy(targetID, cronStr string, priority int, triggeredBy string) (*ReplicationPolicy, error) {
+	if targetID == "" {
+		return nil, errors.New("replication policy target_id cannot be empty")
+	}
+	if triggeredBy == "" {
+		return nil, errors.New("replication policy triggered_by cannot be empty")
+	}
+
+	now := time.Now()
+	return &ReplicationPolicy{
+		ID:             uuid.NewString(),
+		SourceResource: ReplicationSourcePolicies,
+		TargetID:       targetID,
+		CronStr:        cronStr,
+		Priority:       priority,
+		TriggeredBy:    triggeredBy,
+		Enabled:        true,
+		CreationTime:   now,
+		UpdateTime:     now,
+	}, nil
+}
+
+// ReplicationExecutionStatus is the lifecycle state of a single replication
+// run.
+type ReplicationExecutionStatus string
+
+const (
+	ReplicationExecutionPending   ReplicationExecutionStatus = "pending"
+	ReplicationExecutionRunning   ReplicationExecutionStatus = "running"
+	ReplicationExecutionSucceeded ReplicationExecutionStatus = "succeeded"
+	ReplicationExecutionFailed    ReplicationExecutionStatus = "failed"
+)
+
+// ReplicationExecution is a single (scheduled or manually triggered) run of
+// a ReplicationPolicy pushing a bundle to its target, the replication
+// subsystem's equivalent of a Job: it records what happened, when, and
+// with which bundle version, for GET /api/v1/replication/executions.
+type ReplicationExecution struct {
+	ID       string
+	PolicyID string
+	TargetID string
+	Status   ReplicationExecutionStatus
+
+	// Version is the bundle version this execution pushed (or attempted
+	// to push); it comes from ReplicationPolicyRepository's per-policy
+	// version counter at the time the execution was created.
+	Version uint64
+
+	// Attempt is the retry attempt number, starting at 1. Retries back off
+	// exponentially; see replication.Pusher.
+	Attempt int
+	Error   string
+
+	CreationTime time.Time
+	UpdateTime   time.Time
+	StartTime    *time.Time
+	EndTime      *time.Time
+}
+
+// NewReplicationExecution creates a new, pending ReplicationExecution for
+// policy's current run.
+func NewReplicationExecution(policyID, targetID string, version uint64, attempt int) *ReplicationExecution {
+	now := time.Now()
+	return &ReplicationExecution{
+		ID:           uuid.NewString(),
+		PolicyID:     policyID,
+		TargetID:     targetID,
+		Status:       ReplicationExecutionPending,
+		Version:      version,
+		Attempt:      attempt,
+		CreationTime: now,
+		UpdateTime:   now,
+	}
+}
+
+// MarkRunning transitions a pending execution to running, recording
+// StartTime.
+func (e *ReplicationExecution) MarkRunning() error {
+	if e.Status != ReplicationExecutionPending {
+		return fmt.Errorf("replication execution %s is not pending", e.ID)
+	}
+	now := time.Now()
+	e.Status = ReplicationExecutionRunning
+	e.StartTime = &now
+	e.UpdateTime = now
+	return nil
+}
+
+// MarkSucceeded transitions a running execution to succeeded, recording
+// EndTime.
+func (e *ReplicationExecution) MarkSucceeded() error {
+	if e.Status != ReplicationExecutionRunning {
+		return fmt.Errorf("replication execution %s is not running", e.ID)
+	}
+	now := time.Now()
+	e.Status = ReplicationExecutionSucceeded
+	e.EndTime = &now
+	e.UpdateTime = now
+	return nil
+}
+
+// MarkFailed transitions a running execution to failed, recording err and
+// EndTime.
+func (e *ReplicationExecution) MarkFailed(err error) error {
+	if e.Status != ReplicationExecutionRunning {
+		return fmt.Errorf("replication execution %s is not running", e.ID)
+	}
+	now := time.Now()
+	e.Status = ReplicationExecutionFailed
+	e.Error = err.Error()
+	e.EndTime = &now
+	e.UpdateTime = now
+	return nil
+}
@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SecretIDUnlimitedUses marks a SecretID whose RemainingUses never
+// decrements to exhaustion.
+const SecretIDUnlimitedUses = -1
+
+// SecretID is one credential issued under an AppRole: the caller holds the
+// plaintext value (returned exactly once, by GenerateSecretID), while the
+// server retains only HashedValue, an HMAC-SHA256 of the plaintext, so a
+// compromised datastore doesn't leak usable credentials.
+type SecretID struct {
+	ID          string
+	AppRoleID   string
+	HashedValue []byte
+
+	// ExpiresAt is the zero Time if this SecretID never expires.
+	ExpiresAt time.Time
+	// RemainingUses decrements on every successful login; a use of
+	// SecretIDUnlimitedUses leaves it permanently unlimited, and a value of
+	// 0 means the SecretID is exhausted.
+	RemainingUses int
+	// BoundCIDRs additionally restricts which source IPs may use this
+	// specific SecretID, independent of (and ANDed with) its AppRole's own
+	// BoundCIDRs.
+	BoundCIDRs []string
+	Metadata   map[string]string
+
+	CreatedAt time.Time
+}
+
+// NewSecretID builds a SecretID record for appRoleID, already hashed via
+// hashedValue (see HashSecretIDValue). ttl <= 0 means the SecretID never
+// expires; numUses <= 0 means it's unlimited-use.
+func NewSecretID(appRoleID string, hashedValue []byte, ttl time.Duration, numUses int, boundCIDRs []string, metadata map[string]string) *SecretID {
+	remaining := SecretIDUnlimitedUses
+	if numUses > 0 {
+		remaining = numUses
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	return &SecretID{
+		ID:            uuid.NewString(),
+		AppRoleID:     appRoleID,
+		HashedValue:   hashedValue,
+		ExpiresAt:     expiresAt,
+		RemainingUses: remaining,
+		BoundCIDRs:    boundCIDRs,
+		Metadata:      metadata,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// IsExpired reports whether this SecretID has passed its ExpiresAt, as of
+// now. A zero ExpiresAt never expires.
+func (s *SecretID) IsExpired(now time.Time) bool {
+	return !s.ExpiresAt.IsZero() && now.After(s.ExpiresAt)
+}
+
+// IsExhausted reports whether this SecretID has used up every allowed
+// login. An unlimited-use SecretID is never exhausted.
+func (s *SecretID) IsExhausted() bool {
+	return s.RemainingUses == 0
+}
+
+// AllowsSourceIP reports whether ip satisfies this SecretID's own
+// BoundCIDRs (empty means unrestricted).
+func (s *SecretID) AllowsSourceIP(ip net.IP) bool {
+	return allowsSourceIP(s.BoundCIDRs, ip)
+}
+
+// DecrementUse consumes one login against this SecretID's use budget. A
+// SecretIDUnlimitedUses budget is left untouched.
+func (s *SecretID) DecrementUse() {
+	if s.RemainingUses > 0 {
+		s.RemainingUses--
+	}
+}
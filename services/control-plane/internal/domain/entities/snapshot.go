@@ -0,0 +1,35 @@
+package entities
+
+import "time"
+
+// SnapshotManifest records the metadata of a durable snapshot artifact
+// produced by a snapshot Job. The artifact bytes themselves live in
+// whatever SnapshotStore the deployment is configured with (S3 or local
+// disk), keyed by (TenantID, ID); the manifest is what lets callers list,
+// look up, and download a snapshot without reaching into the store
+// directly.
+type SnapshotManifest struct {
+	ID        string
+	TenantID  string
+	SizeBytes int64
+	SHA256    string
+	CreatedAt time.Time
+
+	// SourceOffset is the core event store's offset/cursor the snapshot
+	// was taken at, so a later replay knows where its data ends.
+	SourceOffset string
+}
+
+// NewSnapshotManifest records a just-completed snapshot upload. id is
+// expected to be the triggering Job's ID, so a manifest's ID always lines
+// up with the job that produced it.
+func NewSnapshotManifest(id, tenantID string, sizeBytes int64, sha256Hex, sourceOffset string) *SnapshotManifest {
+	return &SnapshotManifest{
+		ID:           id,
+		TenantID:     tenantID,
+		SizeBytes:    sizeBytes,
+		SHA256:       sha256Hex,
+		CreatedAt:    time.Now(),
+		SourceOffset: sourceOffset,
+	}
+}
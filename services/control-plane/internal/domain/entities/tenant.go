@@ -14,8 +14,24 @@ type Tenant struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	Metadata    map[string]interface{}
+
+	// PurgeAfter is set when the tenant is soft-deleted and is the point in
+	// time after which a TenantPurgeWorker may cascade-delete the tenant's
+	// remaining resources (users, policies, audit retention). It is nil for
+	// tenants that were never deleted.
+	PurgeAfter *time.Time
+
+	// ResourceVersion increments on every successful write and backs
+	// optimistic-concurrency updates (see TenantRepository.GuaranteedUpdate).
+	// A caller that read version N may only overwrite the record while it
+	// is still at N.
+	ResourceVersion uint64
 }
 
+// DefaultPurgeGracePeriod is how long a soft-deleted tenant is retained
+// before it becomes eligible for purge, unless overridden.
+const DefaultPurgeGracePeriod = 30 * 24 * time.Hour
+
 // TenantStatus represents the status of a tenant
 type TenantStatus string
 
@@ -36,13 +52,14 @@ func NewTenant(id, name, description string) (*Tenant, error) {
 
 	now := time.Now()
 	return &Tenant{
-		ID:          id,
-		Name:        name,
-		Description: description,
-		Status:      TenantStatusActive,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		Metadata:    make(map[string]interface{}),
+		ID:              id,
+		Name:            name,
+		Description:     description,
+		Status:          TenantStatusActive,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Metadata:        make(map[string]interface{}),
+		ResourceVersion: 1,
 	}, nil
 }
 
@@ -85,12 +102,40 @@ func (t *Tenant) Activate() {
 	t.UpdatedAt = time.Now()
 }
 
-// MarkDeleted marks tenant as deleted
+// MarkDeleted soft-deletes the tenant, setting PurgeAfter to
+// DefaultPurgeGracePeriod from now. The tenant remains queryable via
+// FindByIDIncludingDeleted/FindDeleted until a TenantPurgeWorker cascades
+// the actual removal once PurgeAfter has passed.
 func (t *Tenant) MarkDeleted() error {
 	if t.ID == "default" {
 		return errors.New("cannot delete default tenant")
 	}
+	now := time.Now()
+	purgeAfter := now.Add(DefaultPurgeGracePeriod)
 	t.Status = TenantStatusDeleted
+	t.PurgeAfter = &purgeAfter
+	t.UpdatedAt = now
+	return nil
+}
+
+// IsDeleted checks whether the tenant has been soft-deleted
+func (t *Tenant) IsDeleted() bool {
+	return t.Status == TenantStatusDeleted
+}
+
+// IsPurgeable reports whether the tenant is soft-deleted and its grace
+// period has elapsed as of the given time.
+func (t *Tenant) IsPurgeable(asOf time.Time) bool {
+	return t.IsDeleted() && t.PurgeAfter != nil && !asOf.Before(*t.PurgeAfter)
+}
+
+// Restore reactivates a soft-deleted tenant, clearing PurgeAfter.
+func (t *Tenant) Restore() error {
+	if !t.IsDeleted() {
+		return errors.New("tenant is not deleted")
+	}
+	t.Status = TenantStatusActive
+	t.PurgeAfter = nil
 	t.UpdatedAt = time.Now()
 	return nil
 }
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNewTenant(t *testing.T) {
@@ -93,5 +94,42 @@ func TestTenant_MarkDeleted(t *testing.T) {
 		if tenant.Status != TenantStatusDeleted {
 			t.Errorf("Status should be deleted, got %v", tenant.Status)
 		}
+		if tenant.PurgeAfter == nil {
+			t.Fatal("PurgeAfter should be set after soft-delete")
+		}
+		if !tenant.PurgeAfter.After(tenant.UpdatedAt) {
+			t.Error("PurgeAfter should be after the deletion timestamp")
+		}
 	})
 }
+
+func TestTenant_IsPurgeable(t *testing.T) {
+	tenant, _ := NewTenant("tenant-1", "Test", "Test tenant")
+	_ = tenant.MarkDeleted()
+
+	if tenant.IsPurgeable(time.Now()) {
+		t.Error("Tenant should not be purgeable before its grace period elapses")
+	}
+	if !tenant.IsPurgeable(tenant.PurgeAfter.Add(time.Second)) {
+		t.Error("Tenant should be purgeable once its grace period has elapsed")
+	}
+}
+
+func TestTenant_Restore(t *testing.T) {
+	tenant, _ := NewTenant("tenant-1", "Test", "Test tenant")
+
+	if err := tenant.Restore(); err == nil {
+		t.Error("Should not be able to restore a tenant that isn't deleted")
+	}
+
+	_ = tenant.MarkDeleted()
+	if err := tenant.Restore(); err != nil {
+		t.Fatalf("Restore() failed: %v", err)
+	}
+	if tenant.Status != TenantStatusActive {
+		t.Errorf("Status should be active after restore, got %v", tenant.Status)
+	}
+	if tenant.PurgeAfter != nil {
+		t.Error("PurgeAfter should be cleared after restore")
+	}
+}
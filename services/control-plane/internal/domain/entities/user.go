@@ -14,6 +14,12 @@ type User struct {
 	IsAPIKey  bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// ResourceVersion increments on every successful write and backs
+	// optimistic-concurrency updates (see UserRepository.GuaranteedUpdate).
+	// A caller that read version N may only overwrite the record while it
+	// is still at N.
+	ResourceVersion uint64
 }
 
 // Role represents a user's role in the system
@@ -56,13 +62,14 @@ func NewUser(id, username, tenantID string, role Role) (*User, error) {
 
 	now := time.Now()
 	return &User{
-		ID:        id,
-		Username:  username,
-		TenantID:  tenantID,
-		Role:      role,
-		IsAPIKey:  false,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:              id,
+		Username:        username,
+		TenantID:        tenantID,
+		Role:            role,
+		IsAPIKey:        false,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		ResourceVersion: 1,
 	}, nil
 }
 
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code classifies what went wrong in a domain-layer failure, independent
+// of how it's eventually surfaced (HTTP status, gRPC status, log level).
+type Code int
+
+const (
+	// CodeUnknown is the zero value; prefer a more specific code.
+	CodeUnknown Code = iota
+	CodeValidationFailed
+	CodeInternal
+	CodeNoPermission
+	CodeNotFound
+	CodeAlreadyExists
+	CodeConflict
+	CodeUnimplemented
+	CodeUnauthenticated
+	CodeDeadlineExceeded
+)
+
+func (c Code) String() string {
+	switch c {
+	case CodeValidationFailed:
+		return "validation_failed"
+	case CodeInternal:
+		return "internal"
+	case CodeNoPermission:
+		return "no_permission"
+	case CodeNotFound:
+		return "not_found"
+	case CodeAlreadyExists:
+		return "already_exists"
+	case CodeConflict:
+		return "conflict"
+	case CodeUnimplemented:
+		return "unimplemented"
+	case CodeUnauthenticated:
+		return "unauthenticated"
+	case CodeDeadlineExceeded:
+		return "deadline_exceeded"
+	default:
+		return "unknown"
+	}
+}
+
+// Error is a typed domain-layer error carrying a Code that callers (HTTP
+// handlers, gRPC interceptors, logs) can map to their own representation
+// without string-matching Message.
+type Error struct {
+	Code    Code
+	Message string
+	Cause   error
+	// Fields carries structured context (e.g. which field failed
+	// validation), for callers that want more than Message.
+	Fields map[string]string
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+// Unwrap exposes Cause to errors.Is/errors.As.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Wrap builds an *Error of the given code and message, wrapping err as
+// its Cause. err may be nil, e.g. when the failure originates in the
+// domain layer itself rather than from a lower-level call.
+func Wrap(err error, code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg, Cause: err}
+}
+
+// Is reports whether err is (or wraps) a domain *Error with the given
+// Code.
+func Is(err error, code Code) bool {
+	var de *Error
+	if errors.As(err, &de) {
+		return de.Code == code
+	}
+	return false
+}
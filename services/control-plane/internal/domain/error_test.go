@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := Wrap(cause, CodeInternal, "failed to save tenant")
+
+	if err.Code != CodeInternal {
+		t.Errorf("Code = %v, want %v", err.Code, CodeInternal)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Wrap() should preserve cause via Unwrap")
+	}
+	if err.Error() != "failed to save tenant: connection refused" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestIs(t *testing.T) {
+	if !Is(ErrTenantNotFound, CodeNotFound) {
+		t.Error("ErrTenantNotFound should have CodeNotFound")
+	}
+	if Is(ErrTenantNotFound, CodeAlreadyExists) {
+		t.Error("ErrTenantNotFound should not have CodeAlreadyExists")
+	}
+
+	wrapped := Wrap(ErrTenantNotFound, CodeNotFound, "tenant lookup failed")
+	if !Is(wrapped, CodeNotFound) {
+		t.Error("Is() should see through a wrapped *Error")
+	}
+
+	if Is(errors.New("plain error"), CodeInternal) {
+		t.Error("Is() should return false for a non-domain error")
+	}
+}
@@ -1,32 +1,85 @@
 package domain
 
-import "errors"
-
+// Sentinel errors returned by repositories and use cases. Each is a typed
+// *Error so callers can map it to a transport status via its Code (see
+// httpx.WriteError) instead of string-matching Error().
 var (
 	// ErrTenantAlreadyExists is returned when attempting to create a tenant that already exists
-	ErrTenantAlreadyExists = errors.New("tenant already exists")
+	ErrTenantAlreadyExists = &Error{Code: CodeAlreadyExists, Message: "tenant already exists"}
 
 	// ErrTenantNotFound is returned when a tenant is not found
-	ErrTenantNotFound = errors.New("tenant not found")
+	ErrTenantNotFound = &Error{Code: CodeNotFound, Message: "tenant not found"}
 
 	// ErrUserAlreadyExists is returned when attempting to create a user that already exists
-	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserAlreadyExists = &Error{Code: CodeAlreadyExists, Message: "user already exists"}
 
 	// ErrUserNotFound is returned when a user is not found
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound = &Error{Code: CodeNotFound, Message: "user not found"}
 
 	// ErrPolicyAlreadyExists is returned when attempting to create a policy that already exists
-	ErrPolicyAlreadyExists = errors.New("policy already exists")
+	ErrPolicyAlreadyExists = &Error{Code: CodeAlreadyExists, Message: "policy already exists"}
 
 	// ErrPolicyNotFound is returned when a policy is not found
-	ErrPolicyNotFound = errors.New("policy not found")
+	ErrPolicyNotFound = &Error{Code: CodeNotFound, Message: "policy not found"}
+
+	// ErrPolicyRevisionNotFound is returned when a specific policy revision
+	// does not exist
+	ErrPolicyRevisionNotFound = &Error{Code: CodeNotFound, Message: "policy revision not found"}
+
+	// ErrJobNotFound is returned when a job is not found
+	ErrJobNotFound = &Error{Code: CodeNotFound, Message: "job not found"}
+
+	// ErrJobExecutionNotFound is returned when a job execution is not found
+	ErrJobExecutionNotFound = &Error{Code: CodeNotFound, Message: "job execution not found"}
+
+	// ErrAccessRequestNotFound is returned when an access request is not found
+	ErrAccessRequestNotFound = &Error{Code: CodeNotFound, Message: "access request not found"}
+
+	// ErrSnapshotNotFound is returned when a snapshot manifest, or its
+	// underlying artifact, is not found
+	ErrSnapshotNotFound = &Error{Code: CodeNotFound, Message: "snapshot not found"}
+
+	// ErrReplicationTargetNotFound is returned when a replication target is not found
+	ErrReplicationTargetNotFound = &Error{Code: CodeNotFound, Message: "replication target not found"}
+
+	// ErrReplicationPolicyNotFound is returned when a replication policy is not found
+	ErrReplicationPolicyNotFound = &Error{Code: CodeNotFound, Message: "replication policy not found"}
 
-	// ErrUnauthorized is returned when a user is not authorized
-	ErrUnauthorized = errors.New("unauthorized")
+	// ErrReplicationExecutionNotFound is returned when a replication execution is not found
+	ErrReplicationExecutionNotFound = &Error{Code: CodeNotFound, Message: "replication execution not found"}
 
-	// ErrForbidden is returned when an action is forbidden
-	ErrForbidden = errors.New("forbidden")
+	// ErrAppRoleNotFound is returned when an AppRole is not found
+	ErrAppRoleNotFound = &Error{Code: CodeNotFound, Message: "approle not found"}
+
+	// ErrSecretIDNotFound is returned when a SecretID is not found
+	ErrSecretIDNotFound = &Error{Code: CodeNotFound, Message: "secret id not found"}
+
+	// ErrSecretIDInvalid is returned when a presented secret_id doesn't match
+	// any stored hash for the given role, or is expired/exhausted/out of its
+	// bound CIDR range.
+	ErrSecretIDInvalid = &Error{Code: CodeUnauthenticated, Message: "invalid, expired, or exhausted secret_id"}
+
+	// ErrUnauthorized is returned when a caller's identity can't be established
+	ErrUnauthorized = &Error{Code: CodeUnauthenticated, Message: "unauthorized"}
+
+	// ErrForbidden is returned when an authenticated caller lacks permission for an action
+	ErrForbidden = &Error{Code: CodeNoPermission, Message: "forbidden"}
 
 	// ErrInvalidInput is returned when input validation fails
-	ErrInvalidInput = errors.New("invalid input")
+	ErrInvalidInput = &Error{Code: CodeValidationFailed, Message: "invalid input"}
+
+	// ErrInternal is returned in place of an unexpected error (e.g. a
+	// recovered panic) whose details shouldn't be reflected back to the
+	// caller.
+	ErrInternal = &Error{Code: CodeInternal, Message: "internal server error"}
+
+	// ErrConflict is returned by a GuaranteedUpdate when the retry budget
+	// is exhausted because ResourceVersion kept moving under concurrent
+	// writers.
+	ErrConflict = &Error{Code: CodeConflict, Message: "resource was concurrently modified"}
+
+	// ErrRepositoryReadOnly is returned by a repository's mutating methods
+	// when it was constructed in read-only mode, e.g. a FilePolicyRepository
+	// whose source of truth is a file under external (GitOps) management.
+	ErrRepositoryReadOnly = &Error{Code: CodeUnimplemented, Message: "repository is read-only"}
 )
@@ -0,0 +1,23 @@
+package repositories
+
+import "github.com/allsource/control-plane/internal/domain/entities"
+
+// AccessRequestRepository defines the interface for access-request
+// persistence.
+type AccessRequestRepository interface {
+	// Save persists a new access request
+	Save(req *entities.AccessRequest) error
+
+	// FindByID retrieves an access request by ID
+	FindByID(id string) (*entities.AccessRequest, error)
+
+	// FindAll retrieves every access request
+	FindAll() ([]*entities.AccessRequest, error)
+
+	// FindPending retrieves every access request still in PENDING state,
+	// for the background reaper to check against ExpiresAt
+	FindPending() ([]*entities.AccessRequest, error)
+
+	// Update persists changes to an existing access request
+	Update(req *entities.AccessRequest) error
+}
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// AppRoleRepository defines the interface for AppRole and SecretID
+// persistence.
+type AppRoleRepository interface {
+	// SaveAppRole persists a new AppRole.
+	SaveAppRole(role *entities.AppRole) error
+
+	// FindAppRoleByID retrieves an AppRole by its stable, internal ID.
+	FindAppRoleByID(id string) (*entities.AppRole, error)
+
+	// FindAppRoleByRoleID retrieves an AppRole by the public RoleID a
+	// caller presents at login.
+	FindAppRoleByRoleID(roleID string) (*entities.AppRole, error)
+
+	// FindAllAppRoles retrieves every AppRole.
+	FindAllAppRoles() ([]*entities.AppRole, error)
+
+	// UpdateAppRole persists changes to an existing AppRole.
+	UpdateAppRole(role *entities.AppRole) error
+
+	// SaveSecretID persists a newly generated SecretID.
+	SaveSecretID(secretID *entities.SecretID) error
+
+	// FindSecretIDsByAppRole retrieves every SecretID issued under
+	// appRoleID, for login lookup and management listing.
+	FindSecretIDsByAppRole(appRoleID string) ([]*entities.SecretID, error)
+
+	// UpdateSecretID persists changes to an existing SecretID, e.g. a
+	// decremented RemainingUses after a successful login.
+	UpdateSecretID(secretID *entities.SecretID) error
+
+	// DeleteSecretID removes a SecretID, e.g. for manual revocation or by
+	// the tidy sweep once it's expired or exhausted.
+	DeleteSecretID(id string) error
+
+	// FindExpiredOrExhaustedSecretIDs retrieves every SecretID that is
+	// expired as of now or has no remaining uses, for the background tidy
+	// sweep to remove.
+	FindExpiredOrExhaustedSecretIDs(now time.Time) ([]*entities.SecretID, error)
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// MaxAuditPageSize bounds AuditQuery.PageSize: a caller asking for more, or
+// for nothing at all (PageSize <= 0), gets this many events back instead.
+const MaxAuditPageSize = 500
+
+// SortOrder selects whether AuditQuery.Query walks events oldest-first or
+// newest-first.
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Cursor marks a position in a sorted audit event stream: the timestamp
+// and ID of the last event already returned. Pairing ID with Timestamp
+// breaks ties between events logged in the same instant, which a
+// timestamp alone can't.
+type Cursor struct {
+	LastTimestamp time.Time
+	LastID        string
+}
+
+// EncodeCursor renders c as the opaque, base64-encoded token AuditPage
+// hands back to callers as NextCursor.
+func EncodeCursor(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a token produced by EncodeCursor. An empty token
+// decodes to the zero Cursor and no error, so callers can pass through an
+// absent AuditQuery.Cursor without a special case.
+func DecodeCursor(token string) (Cursor, error) {
+	if token == "" {
+		return Cursor{}, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return c, nil
+}
+
+// AuditQuery describes a filtered, paginated scan over audit events.
+// Every filter field is optional; a zero value means "don't filter on
+// this".
+type AuditQuery struct {
+	UserID   string
+	TenantID string
+	Resource string
+	Action   string
+
+	// MinStatusCode and MaxStatusCode bound StatusCode when either is
+	// non-zero. Set both to the same value to match one exact code.
+	MinStatusCode int
+	MaxStatusCode int
+
+	// IsError, if non-nil, requires AuditEvent.IsError() to equal *IsError.
+	IsError *bool
+
+	// Start and End bound Timestamp; either may be the zero Time to leave
+	// that side unbounded.
+	Start time.Time
+	End   time.Time
+
+	// MetadataContains, if set, requires some value in Metadata to contain
+	// this substring (case-insensitive), for an ad hoc free-text search
+	// over the JSON blob rather than a specific known key.
+	MetadataContains string
+
+	// SortOrder defaults to SortDesc (most recent first) when empty.
+	SortOrder SortOrder
+	// Cursor resumes a previous query from the last event it returned;
+	// nil starts from the beginning of the sort order.
+	Cursor *Cursor
+	// PageSize is clamped to MaxAuditPageSize; <= 0 uses MaxAuditPageSize.
+	PageSize int
+}
+
+// Normalize returns a copy of q with SortOrder and PageSize defaulted and
+// clamped, so every Query implementation can rely on those fields being
+// well-formed without repeating the same checks.
+func (q AuditQuery) Normalize() AuditQuery {
+	if q.SortOrder == "" {
+		q.SortOrder = SortDesc
+	}
+	if q.PageSize <= 0 || q.PageSize > MaxAuditPageSize {
+		q.PageSize = MaxAuditPageSize
+	}
+	return q
+}
+
+// AuditPage is one page of an AuditQuery, with enough information to fetch
+// the next one.
+type AuditPage struct {
+	Events     []*entities.AuditEvent
+	NextCursor string
+	HasMore    bool
+}
@@ -21,4 +21,10 @@ type AuditRepository interface {
 
 	// FindErrors retrieves audit events that represent errors
 	FindErrors(limit int) ([]*entities.AuditEvent, error)
+
+	// Query runs a filtered, cursor-paginated scan over audit events,
+	// for callers that need more than one dimension of filtering (or
+	// need to page through a result set larger than a single limit-bounded
+	// call can return).
+	Query(q AuditQuery) (*AuditPage, error)
 }
@@ -0,0 +1,23 @@
+package repositories
+
+import "github.com/allsource/control-plane/internal/domain/entities"
+
+// JobExecutionRepository defines the interface for job execution
+// persistence.
+type JobExecutionRepository interface {
+	// Save persists a new execution.
+	Save(execution *entities.JobExecution) error
+
+	// FindByID retrieves an execution by ID.
+	FindByID(id string) (*entities.JobExecution, error)
+
+	// FindByJobID retrieves every execution of the given job, most
+	// recently created first.
+	FindByJobID(jobID string) ([]*entities.JobExecution, error)
+
+	// FindAll retrieves every execution, most recently created first.
+	FindAll() ([]*entities.JobExecution, error)
+
+	// Update persists changes to an existing execution.
+	Update(execution *entities.JobExecution) error
+}
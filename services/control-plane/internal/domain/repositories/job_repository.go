@@ -0,0 +1,22 @@
+package repositories
+
+import "github.com/allsource/control-plane/internal/domain/entities"
+
+// JobRepository defines the interface for job persistence
+type JobRepository interface {
+	// Save persists a new job
+	Save(job *entities.Job) error
+
+	// FindByID retrieves a job by ID
+	FindByID(id string) (*entities.Job, error)
+
+	// FindAll retrieves every job
+	FindAll() ([]*entities.Job, error)
+
+	// FindScheduled retrieves every job with a non-empty CronStr, for a
+	// JobDispatcher's periodic re-triggering
+	FindScheduled() ([]*entities.Job, error)
+
+	// Update persists changes to an existing job
+	Update(job *entities.Job) error
+}
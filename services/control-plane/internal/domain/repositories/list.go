@@ -0,0 +1,21 @@
+package repositories
+
+// ListOptions controls pagination for List-style repository queries that
+// need to scale past a single in-memory scan.
+type ListOptions struct {
+	// TenantID, if set, restricts results to that tenant. Ignored by
+	// repositories whose entity has no notion of tenant scoping.
+	TenantID string
+
+	// PageSize caps the number of items a single List call returns.
+	// Implementations apply DefaultListPageSize when PageSize <= 0.
+	PageSize int
+
+	// PageToken is the opaque continuation token returned as nextToken by
+	// a previous List call. Empty requests the first page.
+	PageToken string
+}
+
+// DefaultListPageSize is used by List implementations when
+// ListOptions.PageSize is not set.
+const DefaultListPageSize = 100
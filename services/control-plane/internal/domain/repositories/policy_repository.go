@@ -1,6 +1,10 @@
 package repositories
 
-import "github.com/allsource/control-plane/internal/domain/entities"
+import (
+	"context"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
 
 // PolicyRepository defines the interface for policy persistence
 type PolicyRepository interface {
@@ -27,4 +31,17 @@ type PolicyRepository interface {
 
 	// Exists checks if a policy exists
 	Exists(id string) (bool, error)
+
+	// GuaranteedUpdate performs an optimistic-concurrency update of the
+	// policy with the given id: it reads the current record, calls
+	// tryUpdate with a copy of it, and writes the result back only if no
+	// other writer has advanced ResourceVersion in the meantime. On a
+	// version conflict it re-reads and retries tryUpdate, up to a bounded
+	// number of attempts, returning domain.ErrConflict if the record still
+	// won't settle. It returns the final stored policy.
+	GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Policy) (*entities.Policy, error)) (*entities.Policy, error)
+
+	// List returns a page of policies along with a continuation token for
+	// the next page, empty once there are no more results.
+	List(ctx context.Context, opts ListOptions) (policies []*entities.Policy, nextToken string, err error)
 }
@@ -0,0 +1,27 @@
+package repositories
+
+import "github.com/allsource/control-plane/internal/domain/entities"
+
+// VersionedPolicyRepository extends PolicyRepository with change history,
+// letting administrators answer "who changed this policy, when, and what
+// did it look like before". Implementations must append a new
+// PolicyRevision on every Save/Update/Delete and treat revisions as
+// immutable once written.
+type VersionedPolicyRepository interface {
+	PolicyRepository
+
+	// SaveRevision records the given policy as a new immutable revision for
+	// policyID, attributing the change to actor with an optional comment,
+	// and returns the new revision number.
+	SaveRevision(policy *entities.Policy, changeType entities.PolicyChangeType, actor, comment string) (rev int, err error)
+
+	// ListRevisions returns all revisions for a policy, oldest first.
+	ListRevisions(policyID string) ([]*entities.PolicyRevision, error)
+
+	// GetRevision retrieves a single revision of a policy.
+	GetRevision(policyID string, revision int) (*entities.PolicyRevision, error)
+
+	// Rollback restores policyID to the state captured in revision,
+	// recording the rollback itself as a new revision attributed to actor.
+	Rollback(policyID string, revision int, actor string) (*entities.Policy, error)
+}
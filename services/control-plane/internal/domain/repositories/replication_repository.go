@@ -0,0 +1,66 @@
+package repositories
+
+import "github.com/allsource/control-plane/internal/domain/entities"
+
+// ReplicationTargetRepository defines the interface for replication target
+// persistence.
+type ReplicationTargetRepository interface {
+	// Save persists a new target.
+	Save(target *entities.ReplicationTarget) error
+
+	// FindByID retrieves a target by ID.
+	FindByID(id string) (*entities.ReplicationTarget, error)
+
+	// FindAll retrieves every target.
+	FindAll() ([]*entities.ReplicationTarget, error)
+
+	// Update persists changes to an existing target.
+	Update(target *entities.ReplicationTarget) error
+
+	// Delete removes a target.
+	Delete(id string) error
+}
+
+// ReplicationPolicyRepository defines the interface for replication policy
+// persistence.
+type ReplicationPolicyRepository interface {
+	// Save persists a new policy.
+	Save(policy *entities.ReplicationPolicy) error
+
+	// FindByID retrieves a policy by ID.
+	FindByID(id string) (*entities.ReplicationPolicy, error)
+
+	// FindAll retrieves every policy.
+	FindAll() ([]*entities.ReplicationPolicy, error)
+
+	// FindScheduled retrieves every enabled policy with a non-empty
+	// CronStr, for Scheduler to register with its cron runner.
+	FindScheduled() ([]*entities.ReplicationPolicy, error)
+
+	// Update persists changes to an existing policy.
+	Update(policy *entities.ReplicationPolicy) error
+
+	// Delete removes a policy.
+	Delete(id string) error
+
+	// NextVersion atomically returns the next monotonically increasing
+	// bundle version for policy id, starting at 1. Each successful push
+	// of that policy consumes exactly one version.
+	NextVersion(id string) (uint64, error)
+}
+
+// ReplicationExecutionRepository defines the interface for replication
+// execution persistence.
+type ReplicationExecutionRepository interface {
+	// Save persists a new execution.
+	Save(execution *entities.ReplicationExecution) error
+
+	// FindByID retrieves an execution by ID.
+	FindByID(id string) (*entities.ReplicationExecution, error)
+
+	// FindAll retrieves every execution, most recently created first.
+	FindAll() ([]*entities.ReplicationExecution, error)
+
+	// Update persists changes to an existing execution.
+	Update(execution *entities.ReplicationExecution) error
+}
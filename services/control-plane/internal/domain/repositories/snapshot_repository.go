@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// SnapshotRepository defines the interface for snapshot manifest
+// persistence. It only ever deals in SnapshotManifest metadata; the
+// artifact bytes it points at are owned by a snapshot.Store instead.
+type SnapshotRepository interface {
+	// Save persists a new snapshot manifest
+	Save(manifest *entities.SnapshotManifest) error
+
+	// FindByID retrieves a snapshot manifest by ID
+	FindByID(id string) (*entities.SnapshotManifest, error)
+
+	// List returns a page of snapshot manifests, optionally restricted to
+	// opts.TenantID, along with a continuation token for the next page.
+	List(ctx context.Context, opts ListOptions) (manifests []*entities.SnapshotManifest, nextToken string, err error)
+}
@@ -1,27 +1,60 @@
 package repositories
 
-import "github.com/allsource/control-plane/internal/domain/entities"
+import (
+	"context"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
 
 // TenantRepository defines the interface for tenant persistence
 type TenantRepository interface {
 	// Save persists a tenant
 	Save(tenant *entities.Tenant) error
 
-	// FindByID retrieves a tenant by ID
+	// FindByID retrieves a tenant by ID, excluding soft-deleted tenants so
+	// existing callers don't accidentally see tombstones
 	FindByID(id string) (*entities.Tenant, error)
 
-	// FindAll retrieves all tenants
+	// FindByIDIncludingDeleted retrieves a tenant by ID regardless of
+	// whether it has been soft-deleted
+	FindByIDIncludingDeleted(id string) (*entities.Tenant, error)
+
+	// FindAll retrieves all tenants, excluding soft-deleted ones
 	FindAll() ([]*entities.Tenant, error)
 
 	// FindActive retrieves all active tenants
 	FindActive() ([]*entities.Tenant, error)
 
+	// FindDeleted retrieves all soft-deleted tenants still awaiting purge
+	FindDeleted() ([]*entities.Tenant, error)
+
 	// Update updates an existing tenant
 	Update(tenant *entities.Tenant) error
 
-	// Delete removes a tenant
+	// Delete soft-deletes a tenant, setting its grace period via
+	// Tenant.MarkDeleted rather than removing it immediately
 	Delete(id string) error
 
-	// Exists checks if a tenant exists
+	// Purge permanently removes a tenant that has already been soft-deleted
+	Purge(id string) error
+
+	// Restore reactivates a soft-deleted tenant
+	Restore(id string) error
+
+	// Exists checks if a non-deleted tenant exists
 	Exists(id string) (bool, error)
+
+	// GuaranteedUpdate performs an optimistic-concurrency update of the
+	// tenant with the given id: it reads the current record, calls
+	// tryUpdate with a copy of it, and writes the result back only if no
+	// other writer has advanced ResourceVersion in the meantime. On a
+	// version conflict it re-reads and retries tryUpdate, up to a bounded
+	// number of attempts, returning domain.ErrConflict if the record still
+	// won't settle. It returns the final stored tenant.
+	GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Tenant) (*entities.Tenant, error)) (*entities.Tenant, error)
+
+	// List returns a page of non-deleted tenants along with a
+	// continuation token for the next page, empty once there are no more
+	// results.
+	List(ctx context.Context, opts ListOptions) (tenants []*entities.Tenant, nextToken string, err error)
 }
@@ -1,6 +1,10 @@
 package repositories
 
-import "github.com/allsource/control-plane/internal/domain/entities"
+import (
+	"context"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
 
 // UserRepository defines the interface for user persistence
 type UserRepository interface {
@@ -24,4 +28,20 @@ type UserRepository interface {
 
 	// Exists checks if a user exists
 	Exists(id string) (bool, error)
+
+	// GuaranteedUpdate performs an optimistic-concurrency update of the
+	// user with the given id: it reads the current record, calls tryUpdate
+	// with a copy of it, and writes the result back only if no other
+	// writer has advanced ResourceVersion in the meantime. On a version
+	// conflict it re-reads and retries tryUpdate, up to a bounded number of
+	// attempts, returning domain.ErrConflict if the record still won't
+	// settle. It returns the final stored user.
+	GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.User) (*entities.User, error)) (*entities.User, error)
+
+	// List returns a page of users, optionally restricted to
+	// opts.TenantID, along with a continuation token for the next page.
+	// The token is empty once there are no more results, so FindByTenant
+	// callers that need every user for a tenant can page through List
+	// instead of relying on a single in-memory scan.
+	List(ctx context.Context, opts ListOptions) (users []*entities.User, nextToken string, err error)
 }
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWKSKeySet fetches and caches an OIDC provider's JSON Web Key Set,
+// resolving a `kid` to the RSA public key used to verify tokens it
+// signed. It re-fetches the set at most once per refresh interval, and
+// falls back to a stale cached key rather than failing outright if a
+// refresh attempt errors.
+type JWKSKeySet struct {
+	url     string
+	refresh time.Duration
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+}
+
+// NewJWKSKeySet creates a JWKSKeySet for url, re-fetched at most once per
+// refresh.
+func NewJWKSKeySet(url string, refresh time.Duration) *JWKSKeySet {
+	return &JWKSKeySet{url: url, refresh: refresh}
+}
+
+// Key returns the RSA public key for kid, fetching (or re-fetching, once
+// the cache is older than refresh) the key set as needed.
+func (s *JWKSKeySet) Key(kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[kid]; ok && time.Since(s.fetched) < s.refresh {
+		return key, nil
+	}
+
+	keys, err := fetchJWKS(s.url)
+	if err != nil {
+		if cached, ok := s.keys[kid]; ok {
+			return cached, nil
+		}
+		return nil, err
+	}
+	s.keys = keys
+	s.fetched = time.Now()
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("no JWKS key found for kid %q", kid)
+	}
+	return key, nil
+}
+
+type jwksDocument struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func fetchJWKS(url string) (map[string]*rsa.PublicKey, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
+	var doc jwksDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		pub, err := k.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = pub
+	}
+	return keys, nil
+}
+
+// rsaPublicKey decodes the base64url-encoded modulus (n) and exponent (e)
+// of an RSA JWK into an *rsa.PublicKey.
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	eBuf := make([]byte, 8)
+	copy(eBuf[8-len(eBytes):], eBytes)
+	e := int(binary.BigEndian.Uint64(eBuf))
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: e,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConsoleConfig configures the colorized console writer.
+type ConsoleConfig struct {
+	Color bool `yaml:"color"`
+}
+
+// Config is the root of a logging YAML configuration: a default level,
+// per-subsystem overrides, and the writers every Logger fans out to. At
+// least one of Console, File, or Syslog should be set; if none are, a
+// plain, uncolored console writer is used.
+type Config struct {
+	Default    string            `yaml:"default"`
+	Subsystems map[string]string `yaml:"subsystems"`
+
+	Console *ConsoleConfig      `yaml:"console"`
+	File    *FileWriterConfig   `yaml:"file"`
+	Syslog  *SyslogWriterConfig `yaml:"syslog"`
+}
+
+// LoadConfig reads and parses a logging YAML configuration from path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logging config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse logging config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Registry builds and caches per-subsystem Loggers that share a common set
+// of writers, with levels resolved from Config.Subsystems (falling back to
+// Config.Default, then LevelInfo).
+type Registry struct {
+	mu      sync.Mutex
+	cfg     Config
+	writers []Writer
+	loggers map[string]*Logger
+}
+
+// NewRegistry builds the writers selected by cfg and returns a Registry
+// that hands out Loggers backed by them.
+func NewRegistry(cfg Config) (*Registry, error) {
+	var writers []Writer
+
+	if cfg.Console != nil {
+		writers = append(writers, NewConsoleWriter(os.Stdout, cfg.Console.Color))
+	}
+	if cfg.File != nil {
+		fw, err := NewFileWriter(*cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		writers = append(writers, fw)
+	}
+	if cfg.Syslog != nil {
+		sw, err := NewSyslogWriter(*cfg.Syslog)
+		if err != nil {
+			return nil, err
+		}
+		writers = append(writers, sw)
+	}
+
+	if len(writers) == 0 {
+		writers = append(writers, NewConsoleWriter(os.Stdout, false))
+	}
+
+	return &Registry{cfg: cfg, writers: writers, loggers: make(map[string]*Logger)}, nil
+}
+
+func (r *Registry) levelFor(subsystem string) Level {
+	if raw, ok := r.cfg.Subsystems[subsystem]; ok {
+		if level, err := ParseLevel(raw); err == nil {
+			return level
+		}
+	}
+	if r.cfg.Default != "" {
+		if level, err := ParseLevel(r.cfg.Default); err == nil {
+			return level
+		}
+	}
+	return LevelInfo
+}
+
+// Get returns the Logger for subsystem, creating it on first use.
+func (r *Registry) Get(subsystem string) *Logger {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if logger, ok := r.loggers[subsystem]; ok {
+		return logger
+	}
+	logger := newLogger(subsystem, r.levelFor(subsystem), r.writers)
+	r.loggers[subsystem] = logger
+	return logger
+}
+
+// Close closes every writer backing this registry.
+func (r *Registry) Close() error {
+	var errs []error
+	for _, w := range r.writers {
+		if err := w.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// defaultRegistry backs the package-level Get, so callers across the
+// module can fetch a named Logger without threading a Registry through
+// every constructor. SetDefault swaps it, typically once at startup after
+// loading a YAML Config.
+var defaultRegistry = mustDefaultRegistry()
+
+func mustDefaultRegistry() *Registry {
+	registry, err := NewRegistry(Config{Default: "info", Console: &ConsoleConfig{Color: true}})
+	if err != nil {
+		panic(fmt.Sprintf("logging: failed to build default registry: %v", err))
+	}
+	return registry
+}
+
+// SetDefault replaces the package-level registry that Get resolves
+// against.
+func SetDefault(registry *Registry) {
+	defaultRegistry = registry
+}
+
+// Get returns the named Logger from the package-level default registry.
+func Get(subsystem string) *Logger {
+	return defaultRegistry.Get(subsystem)
+}
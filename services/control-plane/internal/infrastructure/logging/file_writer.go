@@ -0,0 +1,147 @@
+package logging
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Defaults for FileWriter rotation.
+const (
+	DefaultFileMaxSizeBytes = 100 * 1024 * 1024 // 100MB
+	DefaultFileMaxAge       = 7 * 24 * time.Hour
+)
+
+// FileWriterConfig configures a FileWriter.
+type FileWriterConfig struct {
+	// Path is the active log file. Rotated segments are written alongside
+	// it as Path.<unix-timestamp>.gz.
+	Path string `yaml:"path"`
+	// MaxSizeBytes rotates the file once it would exceed this size.
+	// DefaultFileMaxSizeBytes if zero.
+	MaxSizeBytes int64 `yaml:"max_size_bytes"`
+	// MaxAge rotates the file once it has been open this long, even if it
+	// hasn't hit MaxSizeBytes. DefaultFileMaxAge if zero.
+	MaxAge time.Duration `yaml:"max_age"`
+}
+
+// FileWriter writes log lines to a local file, rotating it by size or age
+// and gzip-compressing each rotated segment.
+type FileWriter struct {
+	cfg    FileWriterConfig
+	mu     sync.Mutex
+	file   *os.File
+	size   int64
+	opened time.Time
+}
+
+// NewFileWriter opens cfg.Path for append.
+func NewFileWriter(cfg FileWriterConfig) (*FileWriter, error) {
+	if cfg.MaxSizeBytes <= 0 {
+		cfg.MaxSizeBytes = DefaultFileMaxSizeBytes
+	}
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = DefaultFileMaxAge
+	}
+
+	w := &FileWriter{cfg: cfg}
+	if err := w.openLocked(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *FileWriter) openLocked() error {
+	file, err := os.OpenFile(w.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	w.file = file
+	w.size = info.Size()
+	w.opened = time.Now()
+	return nil
+}
+
+// Write appends entry to the file, rotating first if the file has grown
+// past MaxSizeBytes or been open longer than MaxAge.
+func (w *FileWriter) Write(entry Entry) error {
+	line := formatLine(entry) + "\n"
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.size+int64(len(line)) > w.cfg.MaxSizeBytes || time.Since(w.opened) > w.cfg.MaxAge {
+		if err := w.rotateLocked(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.WriteString(line)
+	w.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write log line: %w", err)
+	}
+	return nil
+}
+
+// rotateLocked closes the active file, gzip-compresses it to
+// Path.<unix-timestamp>.gz, and reopens Path fresh. Callers must hold w.mu.
+func (w *FileWriter) rotateLocked() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file for rotation: %w", err)
+	}
+
+	rotatedPath := fmt.Sprintf("%s.%d", w.cfg.Path, time.Now().Unix())
+	if err := os.Rename(w.cfg.Path, rotatedPath); err != nil {
+		return fmt.Errorf("failed to rename log file for rotation: %w", err)
+	}
+
+	if err := gzipAndRemove(rotatedPath); err != nil {
+		return fmt.Errorf("failed to compress rotated log file: %w", err)
+	}
+
+	return w.openLocked()
+}
+
+// gzipAndRemove compresses path to path+".gz" and removes the uncompressed
+// original.
+func gzipAndRemove(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		gz.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// Close closes the active log file.
+func (w *FileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Logger writes leveled, formatted log lines for a single subsystem (e.g.
+// "audit", "http", "policy") to every Writer it was built with. Entries
+// below the logger's configured Level are dropped before formatting.
+type Logger struct {
+	subsystem string
+	level     Level
+	writers   []Writer
+}
+
+func newLogger(subsystem string, level Level, writers []Writer) *Logger {
+	return &Logger{subsystem: subsystem, level: level, writers: writers}
+}
+
+func (l *Logger) log(level Level, format string, args ...interface{}) {
+	if level < l.level {
+		return
+	}
+
+	entry := Entry{
+		Time:      time.Now(),
+		Level:     level,
+		Subsystem: l.subsystem,
+		Message:   fmt.Sprintf(format, args...),
+	}
+	for _, w := range l.writers {
+		_ = w.Write(entry)
+	}
+}
+
+// Trace logs at LevelTrace.
+func (l *Logger) Trace(format string, args ...interface{}) { l.log(LevelTrace, format, args...) }
+
+// Debug logs at LevelDebug.
+func (l *Logger) Debug(format string, args ...interface{}) { l.log(LevelDebug, format, args...) }
+
+// Info logs at LevelInfo.
+func (l *Logger) Info(format string, args ...interface{}) { l.log(LevelInfo, format, args...) }
+
+// Warn logs at LevelWarn.
+func (l *Logger) Warn(format string, args ...interface{}) { l.log(LevelWarn, format, args...) }
+
+// Error logs at LevelError.
+func (l *Logger) Error(format string, args ...interface{}) { l.log(LevelError, format, args...) }
+
+// Fatal logs at LevelFatal and terminates the process, matching the
+// standard library log.Fatal convention.
+func (l *Logger) Fatal(format string, args ...interface{}) {
+	l.log(LevelFatal, format, args...)
+	os.Exit(1)
+}
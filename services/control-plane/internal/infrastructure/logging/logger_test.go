@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type recordingWriter struct {
+	entries []Entry
+}
+
+func (w *recordingWriter) Write(entry Entry) error {
+	w.entries = append(w.entries, entry)
+	return nil
+}
+
+func (w *recordingWriter) Close() error {
+	return nil
+}
+
+func TestLogger_DropsEntriesBelowLevel(t *testing.T) {
+	writer := &recordingWriter{}
+	logger := newLogger("test", LevelWarn, []Writer{writer})
+
+	logger.Debug("should be dropped")
+	logger.Info("should be dropped")
+	logger.Warn("should be kept")
+	logger.Error("should also be kept")
+
+	if len(writer.entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2", len(writer.entries))
+	}
+	if writer.entries[0].Message != "should be kept" {
+		t.Errorf("entries[0].Message = %q, want %q", writer.entries[0].Message, "should be kept")
+	}
+}
+
+func TestConsoleWriter_WritesFormattedLine(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewConsoleWriter(&buf, false)
+	logger := newLogger("http", LevelInfo, []Writer{writer})
+
+	logger.Info("GET /health %d", 200)
+
+	out := buf.String()
+	if !strings.Contains(out, "http") || !strings.Contains(out, "GET /health 200") {
+		t.Errorf("output = %q, missing subsystem or message", out)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Level
+		wantErr bool
+	}{
+		{"debug", LevelDebug, false},
+		{"WARN", LevelWarn, false},
+		{"", LevelInfo, false},
+		{"bogus", LevelInfo, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLevel(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLevel(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseLevel(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRegistry_GetCachesLoggerPerSubsystem(t *testing.T) {
+	registry, err := NewRegistry(Config{Default: "debug", Subsystems: map[string]string{"policy": "warn"}})
+	if err != nil {
+		t.Fatalf("NewRegistry() failed: %v", err)
+	}
+	defer registry.Close()
+
+	if got := registry.levelFor("policy"); got != LevelWarn {
+		t.Errorf("levelFor(policy) = %v, want %v", got, LevelWarn)
+	}
+	if got := registry.levelFor("http"); got != LevelDebug {
+		t.Errorf("levelFor(http) = %v, want %v", got, LevelDebug)
+	}
+
+	if registry.Get("http") != registry.Get("http") {
+		t.Error("Get() should return the same *Logger for the same subsystem")
+	}
+}
@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+// SyslogWriterConfig configures a SyslogWriter. Network and Address empty
+// dial the local syslog daemon; set them (e.g. "udp", "collector:514") to
+// ship to a remote syslog/SIEM endpoint.
+type SyslogWriterConfig struct {
+	Network string `yaml:"network"`
+	Address string `yaml:"address"`
+	Tag     string `yaml:"tag"`
+}
+
+// SyslogWriter ships log entries to syslog, mapping Level onto the
+// nearest syslog severity.
+type SyslogWriter struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogWriter dials the syslog destination described by cfg.
+func NewSyslogWriter(cfg SyslogWriterConfig) (*SyslogWriter, error) {
+	tag := cfg.Tag
+	if tag == "" {
+		tag = "allsource-control-plane"
+	}
+	writer, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_DAEMON|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, fmt.Errorf("dial syslog: %w", err)
+	}
+	return &SyslogWriter{writer: writer}, nil
+}
+
+// Write ships entry to syslog at the severity matching entry.Level.
+func (w *SyslogWriter) Write(entry Entry) error {
+	msg := fmt.Sprintf("[%s] %s", entry.Subsystem, entry.Message)
+	switch entry.Level {
+	case LevelTrace, LevelDebug:
+		return w.writer.Debug(msg)
+	case LevelInfo:
+		return w.writer.Info(msg)
+	case LevelWarn:
+		return w.writer.Warning(msg)
+	case LevelError:
+		return w.writer.Err(msg)
+	case LevelFatal:
+		return w.writer.Crit(msg)
+	default:
+		return w.writer.Info(msg)
+	}
+}
+
+// Close closes the underlying syslog connection.
+func (w *SyslogWriter) Close() error {
+	return w.writer.Close()
+}
@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Entry is a single log line passed to every configured Writer.
+type Entry struct {
+	Time      time.Time
+	Level     Level
+	Subsystem string
+	Message   string
+}
+
+// Writer is a single destination for log entries: a console, a rotating
+// file, syslog. Implementations must be safe for concurrent use; Logger
+// fans an entry out to every writer it was built with.
+type Writer interface {
+	Write(entry Entry) error
+	Close() error
+}
+
+// ansiColors maps each level to the ANSI color code ConsoleWriter wraps the
+// formatted line in, when color output is enabled.
+var ansiColors = map[Level]string{
+	LevelTrace: "\033[90m", // bright black
+	LevelDebug: "\033[36m", // cyan
+	LevelInfo:  "\033[32m", // green
+	LevelWarn:  "\033[33m", // yellow
+	LevelError: "\033[31m", // red
+	LevelFatal: "\033[41m", // red background
+}
+
+const ansiReset = "\033[0m"
+
+// ConsoleWriter writes log lines to out, optionally wrapped in an ANSI
+// color attribute keyed by level.
+type ConsoleWriter struct {
+	out   io.Writer
+	color bool
+	mu    sync.Mutex
+}
+
+// NewConsoleWriter creates a ConsoleWriter writing to out.
+func NewConsoleWriter(out io.Writer, color bool) *ConsoleWriter {
+	return &ConsoleWriter{out: out, color: color}
+}
+
+// Write formats and writes entry, one line per call.
+func (w *ConsoleWriter) Write(entry Entry) error {
+	line := formatLine(entry)
+	if w.color {
+		line = ansiColors[entry.Level] + line + ansiReset
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err := io.WriteString(w.out, line+"\n")
+	return err
+}
+
+// Close is a no-op: ConsoleWriter does not own out.
+func (w *ConsoleWriter) Close() error {
+	return nil
+}
+
+// formatLine renders entry as "2026-07-25T10:00:00Z [INFO] subsystem: message".
+func formatLine(entry Entry) string {
+	return fmt.Sprintf("%s [%-5s] %s: %s",
+		entry.Time.Format(time.RFC3339), levelTag(entry.Level), entry.Subsystem, entry.Message)
+}
+
+func levelTag(l Level) string {
+	switch l {
+	case LevelTrace:
+		return "TRACE"
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	case LevelFatal:
+		return "FATAL"
+	default:
+		return "?????"
+	}
+}
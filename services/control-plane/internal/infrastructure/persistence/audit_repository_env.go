@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AuditBackend selects which AuditRepository implementation
+// NewAuditRepositoryFromEnv builds.
+type AuditBackend string
+
+const (
+	AuditBackendMemory   AuditBackend = "memory"
+	AuditBackendFile     AuditBackend = "file"
+	AuditBackendPostgres AuditBackend = "postgres"
+)
+
+// NewAuditRepositoryFromEnv builds an AuditRepository selected by the
+// AUDIT_BACKEND environment variable: "memory" (the default, and used for
+// anything unrecognized), "file" (reading AUDIT_REPOSITORY_PATH, default
+// "audit_events.jsonl"), or "postgres" (reading DATABASE_URL and running
+// its migration). The returned closeFn releases whatever resource the
+// backend opened — a file handle, a connection pool — and is always safe
+// to call, including for the memory backend where it's a no-op.
+func NewAuditRepositoryFromEnv(ctx context.Context) (repo repositories.AuditRepository, closeFn func(), err error) {
+	switch AuditBackend(os.Getenv("AUDIT_BACKEND")) {
+	case AuditBackendFile:
+		path := os.Getenv("AUDIT_REPOSITORY_PATH")
+		if path == "" {
+			path = "audit_events.jsonl"
+		}
+		fileRepo, err := NewFileAuditRepository(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("audit backend %q: %w", AuditBackendFile, err)
+		}
+		return fileRepo, func() { fileRepo.Close() }, nil
+
+	case AuditBackendPostgres:
+		url := os.Getenv("DATABASE_URL")
+		if url == "" {
+			return nil, nil, fmt.Errorf("audit backend %q: DATABASE_URL is required", AuditBackendPostgres)
+		}
+		pool, err := pgxpool.New(ctx, url)
+		if err != nil {
+			return nil, nil, fmt.Errorf("audit backend %q: %w", AuditBackendPostgres, err)
+		}
+		pgRepo := NewPostgresAuditRepository(pool)
+		if err := pgRepo.Migrate(ctx); err != nil {
+			pool.Close()
+			return nil, nil, fmt.Errorf("audit backend %q: %w", AuditBackendPostgres, err)
+		}
+		return pgRepo, pool.Close, nil
+
+	default:
+		return NewMemoryAuditRepository(), func() {}, nil
+	}
+}
@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// CloudEventSpecVersion and CloudEventType identify the CloudEvents 1.0
+// envelope AuditSink implementations wrap every event in, so downstream
+// consumers (a SIEM, Loki, ELK) can route on type/source without knowing
+// this service's internal AuditEvent shape.
+const (
+	CloudEventSpecVersion = "1.0"
+	CloudEventType        = "com.allsource.control-plane.audit.v1"
+)
+
+// CloudEvent is the CloudEvents 1.0 envelope a TeeAuditRepository's sinks
+// wrap every AuditEvent in before handing it to the wire format (JSON body,
+// Kafka message value, webhook payload, ...).
+type CloudEvent struct {
+	SpecVersion     string               `json:"specversion"`
+	Type            string               `json:"type"`
+	Source          string               `json:"source"`
+	ID              string               `json:"id"`
+	Time            time.Time            `json:"time"`
+	DataContentType string               `json:"datacontenttype"`
+	Subject         string               `json:"subject,omitempty"`
+	Data            *entities.AuditEvent `json:"data"`
+}
+
+// newCloudEvent wraps event for delivery from source (e.g.
+// "control-plane/audit"). Subject is "<tenant>/<resource>/<resourceID>" so a
+// consumer can route or dedupe without unpacking Data.
+func newCloudEvent(source string, event *entities.AuditEvent) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     CloudEventSpecVersion,
+		Type:            CloudEventType,
+		Source:          source,
+		ID:              event.ID,
+		Time:            event.Timestamp,
+		DataContentType: "application/json",
+		Subject:         fmt.Sprintf("%s/%s/%s", event.TenantID, event.Resource, event.ResourceID),
+		Data:            event,
+	}
+}
+
+// AuditSink is a single streaming destination a TeeAuditRepository fans
+// CloudEvents-wrapped audit events out to: Kafka, NATS JetStream, an HTTP
+// webhook, syslog, stdout. Implementations must be safe for concurrent use.
+type AuditSink interface {
+	// Emit delivers event to the sink, wrapping it in a CloudEvents
+	// envelope first. ctx carries request-scoped cancellation/deadlines.
+	Emit(ctx context.Context, event *entities.AuditEvent) error
+	// Flush blocks until any internally buffered events have been sent.
+	Flush() error
+	// Close flushes and releases the sink's underlying connection/handle.
+	Close() error
+}
+
+// Severity is the coarse level TeeAuditRepository filters events by via
+// AuditSinkConfig.MinSeverity, so a sink can be configured to skip routine
+// 2xx/3xx traffic and only receive warnings and errors.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarn
+	SeverityError
+)
+
+// severityOf classifies event the same way the legacy AuditSink pipeline
+// (see audit_sinks.go in the service root) already does: 5xx or a non-empty
+// Error is SeverityError, 4xx is SeverityWarn, everything else is
+// SeverityInfo.
+func severityOf(event *entities.AuditEvent) Severity {
+	if event.StatusCode >= 500 || event.Error != "" {
+		return SeverityError
+	}
+	if event.StatusCode >= 400 {
+		return SeverityWarn
+	}
+	return SeverityInfo
+}
+
+// AuditSinkConfig pairs a Sink with the filters TeeAuditRepository applies
+// before emitting to it, so a noisy high-volume sink (Kafka) can be spared
+// routine read events that a local stdout sink still receives.
+type AuditSinkConfig struct {
+	Name string
+	Sink AuditSink
+
+	// MinSeverity drops any event below this level. Zero value
+	// (SeverityInfo) matches everything.
+	MinSeverity Severity
+	// EventTypePattern, if set, drops any event whose EventType doesn't
+	// match.
+	EventTypePattern *regexp.Regexp
+	// TenantAllowlist, if non-empty, drops any event whose TenantID isn't
+	// in the list.
+	TenantAllowlist []string
+}
+
+// matches reports whether event passes every filter configured on c.
+func (c AuditSinkConfig) matches(event *entities.AuditEvent) bool {
+	if severityOf(event) < c.MinSeverity {
+		return false
+	}
+	if c.EventTypePattern != nil && !c.EventTypePattern.MatchString(event.EventType) {
+		return false
+	}
+	if len(c.TenantAllowlist) > 0 {
+		allowed := false
+		for _, tenantID := range c.TenantAllowlist {
+			if tenantID == event.TenantID {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/nats-io/nats.go"
+)
+
+// defaultNATSFlushTimeout bounds how long Flush waits for the NATS server
+// to acknowledge outstanding publishes.
+const defaultNATSFlushTimeout = 5 * time.Second
+
+// NATSSinkConfig configures a NATSAuditSink.
+type NATSSinkConfig struct {
+	URL     string
+	Subject string
+	Source  string
+}
+
+// NATSAuditSink publishes CloudEvents-wrapped audit events to a NATS
+// JetStream subject, so a consumer can replay the stream or fan it out to
+// multiple durable subscribers.
+type NATSAuditSink struct {
+	cfg  NATSSinkConfig
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+// NewNATSAuditSink connects to cfg.URL and resolves a JetStream context for
+// publishing to cfg.Subject.
+func NewNATSAuditSink(cfg NATSSinkConfig) (*NATSAuditSink, error) {
+	if cfg.Subject == "" {
+		return nil, fmt.Errorf("nats audit sink: subject is required")
+	}
+
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("connect to nats audit sink: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("open nats jetstream context: %w", err)
+	}
+
+	return &NATSAuditSink{cfg: cfg, conn: conn, js: js}, nil
+}
+
+func (n *NATSAuditSink) Emit(ctx context.Context, event *entities.AuditEvent) error {
+	data, err := json.Marshal(newCloudEvent(n.cfg.Source, event))
+	if err != nil {
+		return fmt.Errorf("marshal audit event for nats: %w", err)
+	}
+	_, err = n.js.Publish(n.cfg.Subject, data)
+	if err != nil {
+		return fmt.Errorf("publish audit event to nats jetstream: %w", err)
+	}
+	return nil
+}
+
+// Flush waits for every message published on this connection to be
+// acknowledged by the server.
+func (n *NATSAuditSink) Flush() error {
+	return n.conn.FlushTimeout(defaultNATSFlushTimeout)
+}
+
+func (n *NATSAuditSink) Close() error {
+	n.conn.Close()
+	return nil
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// StdoutAuditSink writes one CloudEvents-wrapped, JSON-encoded audit event
+// per line to Writer (os.Stdout by default), for local development and
+// debugging where standing up Kafka/NATS/a webhook receiver isn't worth it.
+type StdoutAuditSink struct {
+	source string
+	writer io.Writer
+	mu     sync.Mutex
+}
+
+// NewStdoutAuditSink builds a sink that writes to writer, labeling every
+// CloudEvent with source (e.g. "control-plane/audit").
+func NewStdoutAuditSink(writer io.Writer, source string) *StdoutAuditSink {
+	return &StdoutAuditSink{source: source, writer: writer}
+}
+
+func (s *StdoutAuditSink) Emit(ctx context.Context, event *entities.AuditEvent) error {
+	data, err := json.Marshal(newCloudEvent(s.source, event))
+	if err != nil {
+		return fmt.Errorf("marshal audit event for stdout: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = fmt.Fprintf(s.writer, "%s\n", data)
+	return err
+}
+
+// Flush is a no-op: Emit writes synchronously.
+func (s *StdoutAuditSink) Flush() error { return nil }
+
+// Close is a no-op: StdoutAuditSink doesn't own writer's lifecycle.
+func (s *StdoutAuditSink) Close() error { return nil }
@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// syslogFacilityAuth is the RFC5424 facility code (4 = security/authorization
+// messages) this sink tags every audit event with.
+const syslogFacilityAuth = 4
+
+// SyslogSinkConfig configures a SyslogAuditSink.
+type SyslogSinkConfig struct {
+	// Network and Address dial the syslog/SIEM collector, e.g. ("udp",
+	// "collector:514") or ("tcp", "collector:6514").
+	Network string
+	Address string
+	// AppName identifies this process in the syslog APP-NAME field.
+	// Defaults to "control-plane" if empty.
+	AppName string
+	Source  string
+}
+
+// SyslogAuditSink ships CloudEvents-wrapped audit events to a syslog/SIEM
+// collector as RFC5424-framed messages, one per event. Unlike the legacy
+// AuditSink in the service root (which uses the standard library's RFC3164
+// syslog.Writer), this dials the destination directly so it can emit the
+// RFC5424 header format SIEMs expect.
+type SyslogAuditSink struct {
+	cfg  SyslogSinkConfig
+	conn net.Conn
+	mu   sync.Mutex
+}
+
+// NewSyslogAuditSink dials cfg.Network/cfg.Address and returns a sink ready
+// to emit RFC5424 messages over that connection.
+func NewSyslogAuditSink(cfg SyslogSinkConfig) (*SyslogAuditSink, error) {
+	if cfg.AppName == "" {
+		cfg.AppName = "control-plane"
+	}
+	conn, err := net.Dial(cfg.Network, cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("dial syslog audit sink: %w", err)
+	}
+	return &SyslogAuditSink{cfg: cfg, conn: conn}, nil
+}
+
+func (s *SyslogAuditSink) Emit(ctx context.Context, event *entities.AuditEvent) error {
+	data, err := json.Marshal(newCloudEvent(s.cfg.Source, event))
+	if err != nil {
+		return fmt.Errorf("marshal audit event for syslog: %w", err)
+	}
+
+	hostname, _ := os.Hostname()
+	pri := syslogFacilityAuth*8 + severitySyslogLevel(severityOf(event))
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d %s - %s\n",
+		pri, time.Now().UTC().Format(time.RFC3339), hostname, s.cfg.AppName, os.Getpid(), event.ID, data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.conn.Write([]byte(msg))
+	return err
+}
+
+// severitySyslogLevel maps Severity onto the RFC5424 numeric severity
+// levels (3 = error, 4 = warning, 6 = informational).
+func severitySyslogLevel(sev Severity) int {
+	switch sev {
+	case SeverityError:
+		return 3
+	case SeverityWarn:
+		return 4
+	default:
+		return 6
+	}
+}
+
+// Flush is a no-op: Emit writes synchronously to the connection.
+func (s *SyslogAuditSink) Flush() error { return nil }
+
+func (s *SyslogAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.Close()
+}
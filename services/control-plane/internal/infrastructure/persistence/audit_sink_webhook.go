@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// WebhookSinkConfig configures a WebhookAuditSink.
+type WebhookSinkConfig struct {
+	URL string
+	// SigningSecret, if set, makes WebhookAuditSink attach an
+	// X-Audit-Signature header: "sha256=<hex HMAC-SHA256 of the request
+	// body>", so the receiver can verify the payload came from us and
+	// wasn't tampered with in transit.
+	SigningSecret string
+	// Source labels every delivered CloudEvent (e.g. "control-plane/audit").
+	Source string
+	Client *http.Client
+}
+
+// WebhookAuditSink POSTs each audit event, wrapped in a CloudEvents
+// envelope, to a configured HTTP endpoint.
+type WebhookAuditSink struct {
+	cfg WebhookSinkConfig
+}
+
+// NewWebhookAuditSink validates cfg and returns a ready-to-use sink.
+func NewWebhookAuditSink(cfg WebhookSinkConfig) (*WebhookAuditSink, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("webhook audit sink: URL is required")
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookAuditSink{cfg: cfg}, nil
+}
+
+func (w *WebhookAuditSink) Emit(ctx context.Context, event *entities.AuditEvent) error {
+	data, err := json.Marshal(newCloudEvent(w.cfg.Source, event))
+	if err != nil {
+		return fmt.Errorf("marshal audit event for webhook: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("build webhook audit request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	if w.cfg.SigningSecret != "" {
+		req.Header.Set("X-Audit-Signature", "sha256="+signHMAC(w.cfg.SigningSecret, data))
+	}
+
+	resp, err := w.cfg.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver webhook audit event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook audit sink: %s returned status %d", w.cfg.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+func signHMAC(secret string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Flush is a no-op: Emit delivers synchronously over HTTP.
+func (w *WebhookAuditSink) Flush() error { return nil }
+
+// Close is a no-op: WebhookAuditSink holds no long-lived connection.
+func (w *WebhookAuditSink) Close() error { return nil }
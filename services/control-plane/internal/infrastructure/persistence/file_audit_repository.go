@@ -0,0 +1,166 @@
+package persistence
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// FileAuditRepository is an AuditRepository backed by a local append-only
+// JSONL file. Log appends under a mutex; the Find* methods re-scan the
+// whole file, which is fine for the file backend's target scale (a single
+// control plane instance without Postgres) and keeps it simple and
+// dependency-free.
+type FileAuditRepository struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileAuditRepository opens (creating if necessary) the JSONL file at
+// path for append.
+func NewFileAuditRepository(path string) (*FileAuditRepository, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit repository file: %w", err)
+	}
+	return &FileAuditRepository{path: path, file: file}, nil
+}
+
+// Log appends an audit event as one JSON line.
+func (r *FileAuditRepository) Log(event *entities.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	if _, err := r.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+	return nil
+}
+
+// FindByUser retrieves the most recent audit events for a specific user.
+func (r *FileAuditRepository) FindByUser(userID string, limit int) ([]*entities.AuditEvent, error) {
+	return r.scan(limit, func(e *entities.AuditEvent) bool {
+		return e.UserID == userID
+	})
+}
+
+// FindByTenant retrieves the most recent audit events for a specific
+// tenant.
+func (r *FileAuditRepository) FindByTenant(tenantID string, limit int) ([]*entities.AuditEvent, error) {
+	return r.scan(limit, func(e *entities.AuditEvent) bool {
+		return e.TenantID == tenantID
+	})
+}
+
+// FindByTimeRange retrieves audit events within a time range.
+func (r *FileAuditRepository) FindByTimeRange(start, end time.Time) ([]*entities.AuditEvent, error) {
+	return r.scan(0, func(e *entities.AuditEvent) bool {
+		return e.Timestamp.After(start) && e.Timestamp.Before(end)
+	})
+}
+
+// FindErrors retrieves the most recent audit events that represent
+// errors.
+func (r *FileAuditRepository) FindErrors(limit int) ([]*entities.AuditEvent, error) {
+	return r.scan(limit, func(e *entities.AuditEvent) bool {
+		return e.IsError()
+	})
+}
+
+// scan reads every line of the audit file, in reverse (most recent
+// first), returning the events for which match returns true, stopping
+// once limit matches have been collected (limit <= 0 means unbounded).
+func (r *FileAuditRepository) scan(limit int, match func(*entities.AuditEvent) bool) ([]*entities.AuditEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := os.Open(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit repository file: %w", err)
+	}
+	defer file.Close()
+
+	var all []*entities.AuditEvent
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var event entities.AuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("failed to decode audit event: %w", err)
+		}
+		all = append(all, &event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit repository file: %w", err)
+	}
+
+	var result []*entities.AuditEvent
+	for i := len(all) - 1; i >= 0; i-- {
+		if !match(all[i]) {
+			continue
+		}
+		result = append(result, all[i])
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// Query scans the whole file and filters/sorts/paginates in memory,
+// consistent with scan's own full-scan approach: the file backend isn't
+// meant to serve the volumes where that would matter.
+func (r *FileAuditRepository) Query(q repositories.AuditQuery) (*repositories.AuditPage, error) {
+	q = q.Normalize()
+
+	all, err := r.scan(0, func(*entities.AuditEvent) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*entities.AuditEvent, 0, len(all))
+	for _, event := range all {
+		if matchesAuditQuery(event, q) {
+			matched = append(matched, event)
+		}
+	}
+	sortAuditEvents(matched, q.SortOrder)
+
+	start := 0
+	if q.Cursor != nil {
+		start = auditCursorStart(matched, *q.Cursor, q.SortOrder)
+	}
+
+	end := start + q.PageSize
+	hasMore := end < len(matched)
+	if !hasMore {
+		end = len(matched)
+	}
+	page := matched[start:end]
+
+	nextCursor := ""
+	if hasMore {
+		last := page[len(page)-1]
+		nextCursor = repositories.EncodeCursor(repositories.Cursor{LastTimestamp: last.Timestamp, LastID: last.ID})
+	}
+
+	return &repositories.AuditPage{Events: page, NextCursor: nextCursor, HasMore: hasMore}, nil
+}
+
+// Close closes the underlying file.
+func (r *FileAuditRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
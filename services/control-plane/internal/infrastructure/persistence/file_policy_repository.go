@@ -0,0 +1,296 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// FilePolicyRepository is a PolicyRepository backed by a YAML/JSON file on
+// disk (see policyFileSchema), hot-reloaded via fsnotify whenever the file
+// changes. It exists for operators who want to manage policies the way KES
+// loads its policy set from ServerConfig: edit a file, roll it out through
+// normal config management, no API call or recompile needed.
+type FilePolicyRepository struct {
+	path     string
+	readOnly bool
+
+	mu       sync.RWMutex
+	policies map[string]*entities.Policy
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// NewFilePolicyRepository loads policies from path and starts a background
+// goroutine that reloads whenever the file changes. If path doesn't exist
+// yet, it's seeded with the embedded default policy set on first load.
+// readOnly rejects Save/Update/Delete/GuaranteedUpdate with
+// domain.ErrRepositoryReadOnly, the expected mode when path's source of
+// truth is a GitOps-managed repo rather than this process.
+func NewFilePolicyRepository(path string, readOnly bool) (*FilePolicyRepository, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := os.WriteFile(path, defaultPoliciesYAML, 0644); err != nil {
+			return nil, fmt.Errorf("file policy repository: seed %s: %w", path, err)
+		}
+	}
+
+	repo := &FilePolicyRepository{
+		path:     path,
+		readOnly: readOnly,
+		policies: make(map[string]*entities.Policy),
+		done:     make(chan struct{}),
+	}
+
+	if err := repo.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("file policy repository: create watcher: %w", err)
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("file policy repository: watch %s: %w", path, err)
+	}
+	repo.watcher = watcher
+
+	go repo.watch()
+
+	return repo, nil
+}
+
+// watch re-reads repo.path whenever fsnotify reports a write, create, or
+// rename for it (editors commonly replace a file via rename-over rather
+// than an in-place write, so the directory is watched rather than the file
+// itself). A reload that fails (e.g. a half-written or invalid file) is
+// logged rather than applied, so a bad edit doesn't take down an
+// already-running process.
+func (r *FilePolicyRepository) watch() {
+	target := filepath.Base(r.path)
+	for {
+		select {
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Base(event.Name) != target {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			if err := r.reload(); err != nil {
+				fmt.Fprintf(os.Stderr, "file policy repository: reload %s: %v\n", r.path, err)
+			}
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(os.Stderr, "file policy repository: watcher error: %v\n", err)
+		case <-r.done:
+			return
+		}
+	}
+}
+
+// reload re-parses r.path and, if it parses cleanly, atomically replaces
+// the in-memory ruleset.
+func (r *FilePolicyRepository) reload() error {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("file policy repository: read %s: %w", r.path, err)
+	}
+
+	policies, err := parsePolicyFile(data)
+	if err != nil {
+		return fmt.Errorf("file policy repository: %s: %w", r.path, err)
+	}
+
+	r.mu.Lock()
+	r.policies = policies
+	r.mu.Unlock()
+	return nil
+}
+
+// Close stops the background reload goroutine and its watcher.
+func (r *FilePolicyRepository) Close() error {
+	close(r.done)
+	return r.watcher.Close()
+}
+
+// FindByID retrieves a policy by ID
+func (r *FilePolicyRepository) FindByID(id string) (*entities.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, exists := r.policies[id]
+	if !exists {
+		return nil, domain.ErrPolicyNotFound
+	}
+	return policy, nil
+}
+
+// FindAll retrieves all policies
+func (r *FilePolicyRepository) FindAll() ([]*entities.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.Policy, 0, len(r.policies))
+	for _, policy := range r.policies {
+		result = append(result, policy)
+	}
+	return result, nil
+}
+
+// FindByResource retrieves policies for a specific resource
+func (r *FilePolicyRepository) FindByResource(resource string) ([]*entities.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.Policy, 0)
+	for _, policy := range r.policies {
+		if policy.Resource == resource {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// FindEnabled retrieves all enabled policies
+func (r *FilePolicyRepository) FindEnabled() ([]*entities.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.Policy, 0)
+	for _, policy := range r.policies {
+		if policy.Enabled {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// Exists checks if a policy exists
+func (r *FilePolicyRepository) Exists(id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.policies[id]
+	return exists, nil
+}
+
+// List returns a page of policies ordered by ID for a stable continuation
+// token, mirroring MemoryPolicyRepository.List.
+func (r *FilePolicyRepository) List(ctx context.Context, opts repositories.ListOptions) ([]*entities.Policy, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.policies))
+	for id := range r.policies {
+		ids = append(ids, id)
+	}
+
+	page, nextToken := paginateKeys(ids, opts.PageToken, opts.PageSize)
+
+	result := make([]*entities.Policy, 0, len(page))
+	for _, id := range page {
+		result = append(result, r.policies[id])
+	}
+	return result, nextToken, nil
+}
+
+// Save is rejected when r.readOnly; a file-backed store's content is
+// edited at r.path, not through the repository API.
+func (r *FilePolicyRepository) Save(policy *entities.Policy) error {
+	if r.readOnly {
+		return domain.ErrRepositoryReadOnly
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies[policy.ID] = policy
+	return nil
+}
+
+// Update is rejected when r.readOnly; see Save.
+func (r *FilePolicyRepository) Update(policy *entities.Policy) error {
+	if r.readOnly {
+		return domain.ErrRepositoryReadOnly
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.policies[policy.ID]; !exists {
+		return domain.ErrPolicyNotFound
+	}
+	r.policies[policy.ID] = policy
+	return nil
+}
+
+// Delete is rejected when r.readOnly; see Save.
+func (r *FilePolicyRepository) Delete(id string) error {
+	if r.readOnly {
+		return domain.ErrRepositoryReadOnly
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.policies[id]; !exists {
+		return domain.ErrPolicyNotFound
+	}
+	delete(r.policies, id)
+	return nil
+}
+
+// GuaranteedUpdate is rejected when r.readOnly; see Save. Otherwise it
+// behaves like MemoryPolicyRepository.GuaranteedUpdate: r.mu is held from
+// the read through the write, so neither a concurrent API writer nor a
+// reload triggered by a file change can interleave with it.
+func (r *FilePolicyRepository) GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Policy) (*entities.Policy, error)) (*entities.Policy, error) {
+	if r.readOnly {
+		return nil, domain.ErrRepositoryReadOnly
+	}
+
+	for attempt := 0; attempt < maxGuaranteedUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		current, exists := r.policies[id]
+		if !exists {
+			r.mu.Unlock()
+			return nil, domain.ErrPolicyNotFound
+		}
+		readVersion := current.ResourceVersion
+		currentCopy := *current
+
+		updated, err := tryUpdate(&currentCopy)
+		if err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+
+		if stored := r.policies[id]; stored.ResourceVersion != readVersion {
+			r.mu.Unlock()
+			continue
+		}
+
+		updated.ResourceVersion = readVersion + 1
+		r.policies[id] = updated
+		r.mu.Unlock()
+		return updated, nil
+	}
+
+	return nil, domain.ErrConflict
+}
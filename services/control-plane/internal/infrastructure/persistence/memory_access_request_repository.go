@@ -0,0 +1,85 @@
+package persistence
+
+import (
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// MemoryAccessRequestRepository is an in-memory implementation of
+// AccessRequestRepository
+type MemoryAccessRequestRepository struct {
+	requests map[string]*entities.AccessRequest
+	mu       sync.RWMutex
+}
+
+// NewMemoryAccessRequestRepository creates a new MemoryAccessRequestRepository
+func NewMemoryAccessRequestRepository() *MemoryAccessRequestRepository {
+	return &MemoryAccessRequestRepository{
+		requests: make(map[string]*entities.AccessRequest),
+	}
+}
+
+// Save persists a new access request
+func (r *MemoryAccessRequestRepository) Save(req *entities.AccessRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests[req.ID] = req
+	return nil
+}
+
+// FindByID retrieves an access request by ID
+func (r *MemoryAccessRequestRepository) FindByID(id string) (*entities.AccessRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	req, exists := r.requests[id]
+	if !exists {
+		return nil, domain.ErrAccessRequestNotFound
+	}
+
+	return req, nil
+}
+
+// FindAll retrieves every access request
+func (r *MemoryAccessRequestRepository) FindAll() ([]*entities.AccessRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.AccessRequest, 0, len(r.requests))
+	for _, req := range r.requests {
+		result = append(result, req)
+	}
+
+	return result, nil
+}
+
+// FindPending retrieves every access request still in PENDING state
+func (r *MemoryAccessRequestRepository) FindPending() ([]*entities.AccessRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.AccessRequest, 0)
+	for _, req := range r.requests {
+		if req.State == entities.AccessRequestPending {
+			result = append(result, req)
+		}
+	}
+
+	return result, nil
+}
+
+// Update persists changes to an existing access request
+func (r *MemoryAccessRequestRepository) Update(req *entities.AccessRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.requests[req.ID]; !exists {
+		return domain.ErrAccessRequestNotFound
+	}
+
+	r.requests[req.ID] = req
+	return nil
+}
@@ -0,0 +1,229 @@
+package persistence
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// DefaultAppRoleTidyInterval is how often MemoryAppRoleRepository sweeps for
+// expired or exhausted SecretIDs, unless overridden.
+const DefaultAppRoleTidyInterval = 5 * time.Minute
+
+// MemoryAppRoleRepository is an in-memory implementation of
+// AppRoleRepository. It additionally runs a background tidy goroutine that
+// periodically removes expired or exhausted SecretIDs, so a long-running
+// process doesn't accumulate dead credentials.
+type MemoryAppRoleRepository struct {
+	mu          sync.RWMutex
+	roles       map[string]*entities.AppRole
+	roleIDIndex map[string]string
+	secretIDs   map[string]*entities.SecretID
+
+	interval time.Duration
+	tidying  atomic.Bool
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewMemoryAppRoleRepository creates a MemoryAppRoleRepository and starts
+// its background tidy goroutine, sweeping at most once per interval (or
+// DefaultAppRoleTidyInterval, if interval is zero). Call Close to stop it.
+func NewMemoryAppRoleRepository(interval time.Duration) *MemoryAppRoleRepository {
+	if interval <= 0 {
+		interval = DefaultAppRoleTidyInterval
+	}
+	r := &MemoryAppRoleRepository{
+		roles:       make(map[string]*entities.AppRole),
+		roleIDIndex: make(map[string]string),
+		secretIDs:   make(map[string]*entities.SecretID),
+		interval:    interval,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	go r.tidyLoop()
+	return r
+}
+
+// SaveAppRole persists a new AppRole.
+func (r *MemoryAppRoleRepository) SaveAppRole(role *entities.AppRole) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.roles[role.ID] = role
+	r.roleIDIndex[role.RoleID] = role.ID
+	return nil
+}
+
+// FindAppRoleByID retrieves an AppRole by its stable, internal ID.
+func (r *MemoryAppRoleRepository) FindAppRoleByID(id string) (*entities.AppRole, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	role, exists := r.roles[id]
+	if !exists {
+		return nil, domain.ErrAppRoleNotFound
+	}
+	return role, nil
+}
+
+// FindAppRoleByRoleID retrieves an AppRole by the public RoleID a caller
+// presents at login.
+func (r *MemoryAppRoleRepository) FindAppRoleByRoleID(roleID string) (*entities.AppRole, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, exists := r.roleIDIndex[roleID]
+	if !exists {
+		return nil, domain.ErrAppRoleNotFound
+	}
+	role, exists := r.roles[id]
+	if !exists {
+		return nil, domain.ErrAppRoleNotFound
+	}
+	return role, nil
+}
+
+// FindAllAppRoles retrieves every AppRole.
+func (r *MemoryAppRoleRepository) FindAllAppRoles() ([]*entities.AppRole, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.AppRole, 0, len(r.roles))
+	for _, role := range r.roles {
+		result = append(result, role)
+	}
+	return result, nil
+}
+
+// UpdateAppRole persists changes to an existing AppRole. A RoleID rotation
+// is reflected in roleIDIndex, and the stale RoleID is dropped from it.
+func (r *MemoryAppRoleRepository) UpdateAppRole(role *entities.AppRole) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.roles[role.ID]
+	if !exists {
+		return domain.ErrAppRoleNotFound
+	}
+	if existing.RoleID != role.RoleID {
+		delete(r.roleIDIndex, existing.RoleID)
+		r.roleIDIndex[role.RoleID] = role.ID
+	}
+
+	r.roles[role.ID] = role
+	return nil
+}
+
+// SaveSecretID persists a newly generated SecretID.
+func (r *MemoryAppRoleRepository) SaveSecretID(secretID *entities.SecretID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.secretIDs[secretID.ID] = secretID
+	return nil
+}
+
+// FindSecretIDsByAppRole retrieves every SecretID issued under appRoleID.
+func (r *MemoryAppRoleRepository) FindSecretIDsByAppRole(appRoleID string) ([]*entities.SecretID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.SecretID, 0)
+	for _, secretID := range r.secretIDs {
+		if secretID.AppRoleID == appRoleID {
+			result = append(result, secretID)
+		}
+	}
+	return result, nil
+}
+
+// UpdateSecretID persists changes to an existing SecretID.
+func (r *MemoryAppRoleRepository) UpdateSecretID(secretID *entities.SecretID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.secretIDs[secretID.ID]; !exists {
+		return domain.ErrSecretIDNotFound
+	}
+	r.secretIDs[secretID.ID] = secretID
+	return nil
+}
+
+// DeleteSecretID removes a SecretID.
+func (r *MemoryAppRoleRepository) DeleteSecretID(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.secretIDs[id]; !exists {
+		return domain.ErrSecretIDNotFound
+	}
+	delete(r.secretIDs, id)
+	return nil
+}
+
+// FindExpiredOrExhaustedSecretIDs retrieves every SecretID that is expired
+// as of now or has no remaining uses.
+func (r *MemoryAppRoleRepository) FindExpiredOrExhaustedSecretIDs(now time.Time) ([]*entities.SecretID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.SecretID, 0)
+	for _, secretID := range r.secretIDs {
+		if secretID.IsExpired(now) || secretID.IsExhausted() {
+			result = append(result, secretID)
+		}
+	}
+	return result, nil
+}
+
+// TidyExpiredSecretIDs removes every expired or exhausted SecretID and
+// returns how many were deleted. A CAS flag ensures only one tidy pass
+// runs at a time, so an explicit call racing the background loop is a
+// no-op rather than doing redundant work.
+func (r *MemoryAppRoleRepository) TidyExpiredSecretIDs(now time.Time) int {
+	if !r.tidying.CompareAndSwap(false, true) {
+		return 0
+	}
+	defer r.tidying.Store(false)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removed := 0
+	for id, secretID := range r.secretIDs {
+		if secretID.IsExpired(now) || secretID.IsExhausted() {
+			delete(r.secretIDs, id)
+			removed++
+		}
+	}
+	return removed
+}
+
+// tidyLoop proactively removes expired or exhausted SecretIDs once per
+// interval until Close is called.
+func (r *MemoryAppRoleRepository) tidyLoop() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.TidyExpiredSecretIDs(time.Now())
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// Close stops the background tidy goroutine, blocking until it has
+// exited. Safe to call once; not safe to call concurrently with itself.
+func (r *MemoryAppRoleRepository) Close() {
+	close(r.stop)
+	<-r.done
+}
@@ -1,21 +1,35 @@
 package persistence
 
 import (
-	"github.com/allsource/control-plane/internal/domain/entities"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
 )
 
 // MemoryAuditRepository is an in-memory implementation of AuditRepository
 type MemoryAuditRepository struct {
 	events []entities.AuditEvent
 	mu     sync.RWMutex
+
+	// userIndex and tenantIndex map a UserID/TenantID to the indices (into
+	// events) of its matching entries, in append order. Since Log only
+	// appends, that order is also ascending by Timestamp for all practical
+	// purposes, which Query relies on when sorting or binary-searching.
+	userIndex   map[string][]int
+	tenantIndex map[string][]int
 }
 
 // NewMemoryAuditRepository creates a new MemoryAuditRepository
 func NewMemoryAuditRepository() *MemoryAuditRepository {
 	return &MemoryAuditRepository{
-		events: make([]entities.AuditEvent, 0),
+		events:      make([]entities.AuditEvent, 0),
+		userIndex:   make(map[string][]int),
+		tenantIndex: make(map[string][]int),
 	}
 }
 
@@ -24,7 +38,14 @@ func (r *MemoryAuditRepository) Log(event *entities.AuditEvent) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	idx := len(r.events)
 	r.events = append(r.events, *event)
+	if event.UserID != "" {
+		r.userIndex[event.UserID] = append(r.userIndex[event.UserID], idx)
+	}
+	if event.TenantID != "" {
+		r.tenantIndex[event.TenantID] = append(r.tenantIndex[event.TenantID], idx)
+	}
 	return nil
 }
 
@@ -104,3 +125,173 @@ func (r *MemoryAuditRepository) FindErrors(limit int) ([]*entities.AuditEvent, e
 
 	return result, nil
 }
+
+// Query runs a filtered, cursor-paginated scan over events. It narrows
+// the scan with userIndex/tenantIndex/a binary-searched timestamp range
+// when the query lets it, then applies every remaining filter linearly
+// over that narrowed candidate set.
+func (r *MemoryAuditRepository) Query(q repositories.AuditQuery) (*repositories.AuditPage, error) {
+	q = q.Normalize()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*entities.AuditEvent, 0)
+	for _, i := range r.candidateIndices(q) {
+		event := r.events[i]
+		if matchesAuditQuery(&event, q) {
+			matched = append(matched, &event)
+		}
+	}
+
+	sortAuditEvents(matched, q.SortOrder)
+
+	start := 0
+	if q.Cursor != nil {
+		start = auditCursorStart(matched, *q.Cursor, q.SortOrder)
+	}
+
+	end := start + q.PageSize
+	hasMore := end < len(matched)
+	if !hasMore {
+		end = len(matched)
+	}
+	page := matched[start:end]
+
+	nextCursor := ""
+	if hasMore {
+		last := page[len(page)-1]
+		nextCursor = repositories.EncodeCursor(repositories.Cursor{LastTimestamp: last.Timestamp, LastID: last.ID})
+	}
+
+	return &repositories.AuditPage{Events: page, NextCursor: nextCursor, HasMore: hasMore}, nil
+}
+
+// candidateIndices picks the narrowest available index for q, falling
+// back to every event when none applies. Every candidate is still run
+// through matchesAuditQuery, so picking a wider index than strictly
+// necessary is always safe, just slower.
+func (r *MemoryAuditRepository) candidateIndices(q repositories.AuditQuery) []int {
+	switch {
+	case q.UserID != "":
+		return r.userIndex[q.UserID]
+	case q.TenantID != "":
+		return r.tenantIndex[q.TenantID]
+	case !q.Start.IsZero() || !q.End.IsZero():
+		return r.indicesInTimeRange(q.Start, q.End)
+	default:
+		all := make([]int, len(r.events))
+		for i := range all {
+			all[i] = i
+		}
+		return all
+	}
+}
+
+// indicesInTimeRange binary-searches events for the [start, end] bound,
+// relying on events being appended in non-decreasing Timestamp order.
+func (r *MemoryAuditRepository) indicesInTimeRange(start, end time.Time) []int {
+	lo := 0
+	if !start.IsZero() {
+		lo = sort.Search(len(r.events), func(i int) bool {
+			return !r.events[i].Timestamp.Before(start)
+		})
+	}
+	hi := len(r.events)
+	if !end.IsZero() {
+		hi = sort.Search(len(r.events), func(i int) bool {
+			return r.events[i].Timestamp.After(end)
+		})
+	}
+	if lo >= hi {
+		return nil
+	}
+
+	indices := make([]int, hi-lo)
+	for i := range indices {
+		indices[i] = lo + i
+	}
+	return indices
+}
+
+// matchesAuditQuery applies every AuditQuery filter e hasn't already been
+// narrowed by via candidateIndices.
+func matchesAuditQuery(e *entities.AuditEvent, q repositories.AuditQuery) bool {
+	if q.UserID != "" && e.UserID != q.UserID {
+		return false
+	}
+	if q.TenantID != "" && e.TenantID != q.TenantID {
+		return false
+	}
+	if q.Resource != "" && e.Resource != q.Resource {
+		return false
+	}
+	if q.Action != "" && e.Action != q.Action {
+		return false
+	}
+	if q.MinStatusCode != 0 && e.StatusCode < q.MinStatusCode {
+		return false
+	}
+	if q.MaxStatusCode != 0 && e.StatusCode > q.MaxStatusCode {
+		return false
+	}
+	if q.IsError != nil && e.IsError() != *q.IsError {
+		return false
+	}
+	if !q.Start.IsZero() && e.Timestamp.Before(q.Start) {
+		return false
+	}
+	if !q.End.IsZero() && e.Timestamp.After(q.End) {
+		return false
+	}
+	if q.MetadataContains != "" && !auditMetadataContains(e.Metadata, q.MetadataContains) {
+		return false
+	}
+	return true
+}
+
+// auditMetadataContains reports whether any metadata value contains substr,
+// case-insensitively.
+func auditMetadataContains(metadata map[string]interface{}, substr string) bool {
+	substr = strings.ToLower(substr)
+	for _, v := range metadata {
+		if strings.Contains(strings.ToLower(fmt.Sprint(v)), substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortAuditEvents sorts events by (Timestamp, ID), ascending or descending
+// per order. ID breaks ties between events logged in the same instant.
+func sortAuditEvents(events []*entities.AuditEvent, order repositories.SortOrder) {
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].Timestamp.Equal(events[j].Timestamp) {
+			if order == repositories.SortAsc {
+				return events[i].Timestamp.Before(events[j].Timestamp)
+			}
+			return events[i].Timestamp.After(events[j].Timestamp)
+		}
+		if order == repositories.SortAsc {
+			return events[i].ID < events[j].ID
+		}
+		return events[i].ID > events[j].ID
+	})
+}
+
+// auditCursorStart returns the index of the first event in events (already
+// sorted per order) that comes strictly after c.
+func auditCursorStart(events []*entities.AuditEvent, c repositories.Cursor, order repositories.SortOrder) int {
+	for i, e := range events {
+		if order == repositories.SortAsc {
+			if e.Timestamp.After(c.LastTimestamp) || (e.Timestamp.Equal(c.LastTimestamp) && e.ID > c.LastID) {
+				return i
+			}
+		} else {
+			if e.Timestamp.Before(c.LastTimestamp) || (e.Timestamp.Equal(c.LastTimestamp) && e.ID < c.LastID) {
+				return i
+			}
+		}
+	}
+	return len(events)
+}
@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// MemoryJobExecutionRepository is an in-memory implementation of
+// JobExecutionRepository.
+type MemoryJobExecutionRepository struct {
+	executions map[string]*entities.JobExecution
+	order      []string // insertion order, for FindAll/FindByJobID's most-recent-first
+	mu         sync.RWMutex
+}
+
+// NewMemoryJobExecutionRepository creates a new MemoryJobExecutionRepository.
+func NewMemoryJobExecutionRepository() *MemoryJobExecutionRepository {
+	return &MemoryJobExecutionRepository{
+		executions: make(map[string]*entities.JobExecution),
+	}
+}
+
+// Save persists a new execution.
+func (r *MemoryJobExecutionRepository) Save(execution *entities.JobExecution) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.executions[execution.ID] = execution
+	r.order = append(r.order, execution.ID)
+	return nil
+}
+
+// FindByID retrieves an execution by ID.
+func (r *MemoryJobExecutionRepository) FindByID(id string) (*entities.JobExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	execution, exists := r.executions[id]
+	if !exists {
+		return nil, domain.ErrJobExecutionNotFound
+	}
+	return execution, nil
+}
+
+// FindByJobID retrieves every execution of the given job, most recently
+// created first.
+func (r *MemoryJobExecutionRepository) FindByJobID(jobID string) ([]*entities.JobExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*entities.JobExecution
+	for i := len(r.order) - 1; i >= 0; i-- {
+		execution, ok := r.executions[r.order[i]]
+		if ok && execution.JobID == jobID {
+			result = append(result, execution)
+		}
+	}
+	return result, nil
+}
+
+// FindAll retrieves every execution, most recently created first.
+func (r *MemoryJobExecutionRepository) FindAll() ([]*entities.JobExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.JobExecution, 0, len(r.order))
+	for i := len(r.order) - 1; i >= 0; i-- {
+		if execution, ok := r.executions[r.order[i]]; ok {
+			result = append(result, execution)
+		}
+	}
+	return result, nil
+}
+
+// Update persists changes to an existing execution.
+func (r *MemoryJobExecutionRepository) Update(execution *entities.JobExecution) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.executions[execution.ID]; !exists {
+		return domain.ErrJobExecutionNotFound
+	}
+	r.executions[execution.ID] = execution
+	return nil
+}
@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// MemoryJobRepository is an in-memory implementation of JobRepository
+type MemoryJobRepository struct {
+	jobs map[string]*entities.Job
+	mu   sync.RWMutex
+}
+
+// NewMemoryJobRepository creates a new MemoryJobRepository
+func NewMemoryJobRepository() *MemoryJobRepository {
+	return &MemoryJobRepository{
+		jobs: make(map[string]*entities.Job),
+	}
+}
+
+// Save persists a new job
+func (r *MemoryJobRepository) Save(job *entities.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.jobs[job.ID] = job
+	return nil
+}
+
+// FindByID retrieves a job by ID
+func (r *MemoryJobRepository) FindByID(id string) (*entities.Job, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	job, exists := r.jobs[id]
+	if !exists {
+		return nil, domain.ErrJobNotFound
+	}
+
+	return job, nil
+}
+
+// FindAll retrieves every job
+func (r *MemoryJobRepository) FindAll() ([]*entities.Job, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.Job, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		result = append(result, job)
+	}
+
+	return result, nil
+}
+
+// FindScheduled retrieves every job with a non-empty CronStr
+func (r *MemoryJobRepository) FindScheduled() ([]*entities.Job, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.Job, 0)
+	for _, job := range r.jobs {
+		if job.CronStr != "" {
+			result = append(result, job)
+		}
+	}
+
+	return result, nil
+}
+
+// Update persists changes to an existing job
+func (r *MemoryJobRepository) Update(job *entities.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.jobs[job.ID]; !exists {
+		return domain.ErrJobNotFound
+	}
+
+	r.jobs[job.ID] = job
+	return nil
+}
@@ -1,9 +1,12 @@
 package persistence
 
 import (
+	"context"
+	"sync"
+
 	"github.com/allsource/control-plane/internal/domain"
 	"github.com/allsource/control-plane/internal/domain/entities"
-	"sync"
+	"github.com/allsource/control-plane/internal/domain/repositories"
 )
 
 // MemoryPolicyRepository is an in-memory implementation of PolicyRepository
@@ -21,33 +24,14 @@ func NewMemoryPolicyRepository() *MemoryPolicyRepository {
 	return repo
 }
 
-// addDefaultPolicies adds default security policies
+// addDefaultPolicies loads the default security policies from the
+// embedded default_policies.yaml, so operators can see (and override,
+// via NewFilePolicyRepository) the exact same definitions this store
+// seeds itself with.
 func (r *MemoryPolicyRepository) addDefaultPolicies() {
-	// Policy 1: Prevent deletion of default tenant
-	policy1, _ := entities.NewPolicy(
-		"prevent-default-tenant-deletion",
-		"Prevent Default Tenant Deletion",
-		"Prevents deletion of the default tenant",
-		"tenant",
-		entities.ActionDeny,
-		100,
-	)
-	_ = policy1.AddCondition("tenant_id", "eq", "default")
-	_ = policy1.AddCondition("operation", "eq", "delete")
-	_ = r.Save(policy1)
-
-	// Policy 2: Require admin for tenant creation
-	policy2, _ := entities.NewPolicy(
-		"require-admin-tenant-create",
-		"Require Admin for Tenant Creation",
-		"Only admins can create new tenants",
-		"tenant",
-		entities.ActionDeny,
-		90,
-	)
-	_ = policy2.AddCondition("operation", "eq", "create")
-	_ = policy2.AddCondition("role", "ne", "Admin")
-	_ = r.Save(policy2)
+	for _, policy := range defaultPolicies() {
+		_ = r.Save(policy)
+	}
 }
 
 // Save persists a policy
@@ -149,3 +133,61 @@ func (r *MemoryPolicyRepository) Exists(id string) (bool, error) {
 	_, exists := r.policies[id]
 	return exists, nil
 }
+
+// GuaranteedUpdate reads the current policy, applies tryUpdate to a copy,
+// and CAS-writes the result back only if ResourceVersion still matches
+// what was read, retrying on conflict up to maxGuaranteedUpdateAttempts.
+func (r *MemoryPolicyRepository) GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Policy) (*entities.Policy, error)) (*entities.Policy, error) {
+	for attempt := 0; attempt < maxGuaranteedUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		current, exists := r.policies[id]
+		if !exists {
+			r.mu.Unlock()
+			return nil, domain.ErrPolicyNotFound
+		}
+		readVersion := current.ResourceVersion
+		currentCopy := *current
+
+		updated, err := tryUpdate(&currentCopy)
+		if err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+
+		if stored := r.policies[id]; stored.ResourceVersion != readVersion {
+			r.mu.Unlock()
+			continue
+		}
+
+		updated.ResourceVersion = readVersion + 1
+		r.policies[id] = updated
+		r.mu.Unlock()
+		return updated, nil
+	}
+
+	return nil, domain.ErrConflict
+}
+
+// List returns a page of policies ordered by ID for a stable
+// continuation token.
+func (r *MemoryPolicyRepository) List(ctx context.Context, opts repositories.ListOptions) ([]*entities.Policy, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.policies))
+	for id := range r.policies {
+		ids = append(ids, id)
+	}
+
+	page, nextToken := paginateKeys(ids, opts.PageToken, opts.PageSize)
+
+	result := make([]*entities.Policy, 0, len(page))
+	for _, id := range page {
+		result = append(result, r.policies[id])
+	}
+	return result, nextToken, nil
+}
@@ -0,0 +1,245 @@
+package persistence
+
+import (
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// MemoryReplicationTargetRepository is an in-memory implementation of
+// ReplicationTargetRepository.
+type MemoryReplicationTargetRepository struct {
+	targets map[string]*entities.ReplicationTarget
+	mu      sync.RWMutex
+}
+
+// NewMemoryReplicationTargetRepository creates a new
+// MemoryReplicationTargetRepository.
+func NewMemoryReplicationTargetRepository() *MemoryReplicationTargetRepository {
+	return &MemoryReplicationTargetRepository{
+		targets: make(map[string]*entities.ReplicationTarget),
+	}
+}
+
+// Save persists a new target.
+func (r *MemoryReplicationTargetRepository) Save(target *entities.ReplicationTarget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.targets[target.ID] = target
+	return nil
+}
+
+// FindByID retrieves a target by ID.
+func (r *MemoryReplicationTargetRepository) FindByID(id string) (*entities.ReplicationTarget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	target, exists := r.targets[id]
+	if !exists {
+		return nil, domain.ErrReplicationTargetNotFound
+	}
+	return target, nil
+}
+
+// FindAll retrieves every target.
+func (r *MemoryReplicationTargetRepository) FindAll() ([]*entities.ReplicationTarget, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.ReplicationTarget, 0, len(r.targets))
+	for _, target := range r.targets {
+		result = append(result, target)
+	}
+	return result, nil
+}
+
+// Update persists changes to an existing target.
+func (r *MemoryReplicationTargetRepository) Update(target *entities.ReplicationTarget) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.targets[target.ID]; !exists {
+		return domain.ErrReplicationTargetNotFound
+	}
+	r.targets[target.ID] = target
+	return nil
+}
+
+// Delete removes a target.
+func (r *MemoryReplicationTargetRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.targets[id]; !exists {
+		return domain.ErrReplicationTargetNotFound
+	}
+	delete(r.targets, id)
+	return nil
+}
+
+// MemoryReplicationPolicyRepository is an in-memory implementation of
+// ReplicationPolicyRepository.
+type MemoryReplicationPolicyRepository struct {
+	policies map[string]*entities.ReplicationPolicy
+	versions map[string]uint64
+	mu       sync.RWMutex
+}
+
+// NewMemoryReplicationPolicyRepository creates a new
+// MemoryReplicationPolicyRepository.
+func NewMemoryReplicationPolicyRepository() *MemoryReplicationPolicyRepository {
+	return &MemoryReplicationPolicyRepository{
+		policies: make(map[string]*entities.ReplicationPolicy),
+		versions: make(map[string]uint64),
+	}
+}
+
+// Save persists a new policy.
+func (r *MemoryReplicationPolicyRepository) Save(policy *entities.ReplicationPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.policies[policy.ID] = policy
+	return nil
+}
+
+// FindByID retrieves a policy by ID.
+func (r *MemoryReplicationPolicyRepository) FindByID(id string) (*entities.ReplicationPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, exists := r.policies[id]
+	if !exists {
+		return nil, domain.ErrReplicationPolicyNotFound
+	}
+	return policy, nil
+}
+
+// FindAll retrieves every policy.
+func (r *MemoryReplicationPolicyRepository) FindAll() ([]*entities.ReplicationPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.ReplicationPolicy, 0, len(r.policies))
+	for _, policy := range r.policies {
+		result = append(result, policy)
+	}
+	return result, nil
+}
+
+// FindScheduled retrieves every enabled policy with a non-empty CronStr.
+func (r *MemoryReplicationPolicyRepository) FindScheduled() ([]*entities.ReplicationPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.ReplicationPolicy, 0)
+	for _, policy := range r.policies {
+		if policy.Enabled && policy.CronStr != "" {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// Update persists changes to an existing policy.
+func (r *MemoryReplicationPolicyRepository) Update(policy *entities.ReplicationPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.policies[policy.ID]; !exists {
+		return domain.ErrReplicationPolicyNotFound
+	}
+	r.policies[policy.ID] = policy
+	return nil
+}
+
+// Delete removes a policy.
+func (r *MemoryReplicationPolicyRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.policies[id]; !exists {
+		return domain.ErrReplicationPolicyNotFound
+	}
+	delete(r.policies, id)
+	delete(r.versions, id)
+	return nil
+}
+
+// NextVersion returns the next monotonically increasing bundle version for policy id.
+func (r *MemoryReplicationPolicyRepository) NextVersion(id string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.policies[id]; !exists {
+		return 0, domain.ErrReplicationPolicyNotFound
+	}
+	r.versions[id]++
+	return r.versions[id], nil
+}
+
+// MemoryReplicationExecutionRepository is an in-memory implementation of
+// ReplicationExecutionRepository.
+type MemoryReplicationExecutionRepository struct {
+	executions map[string]*entities.ReplicationExecution
+	order      []string
+	mu         sync.RWMutex
+}
+
+// NewMemoryReplicationExecutionRepository creates a new
+// MemoryReplicationExecutionRepository.
+func NewMemoryReplicationExecutionRepository() *MemoryReplicationExecutionRepository {
+	return &MemoryReplicationExecutionRepository{
+		executions: make(map[string]*entities.ReplicationExecution),
+	}
+}
+
+// Save persists a new execution.
+func (r *MemoryReplicationExecutionRepository) Save(execution *entities.ReplicationExecution) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.executions[execution.ID] = execution
+	r.order = append(r.order, execution.ID)
+	return nil
+}
+
+// FindByID retrieves an execution by ID.
+func (r *MemoryReplicationExecutionRepository) FindByID(id string) (*entities.ReplicationExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	execution, exists := r.executions[id]
+	if !exists {
+		return nil, domain.ErrReplicationExecutionNotFound
+	}
+	return execution, nil
+}
+
+// FindAll retrieves every execution, most recently created first.
+func (r *MemoryReplicationExecutionRepository) FindAll() ([]*entities.ReplicationExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.ReplicationExecution, 0, len(r.order))
+	for i := len(r.order) - 1; i >= 0; i-- {
+		if execution, ok := r.executions[r.order[i]]; ok {
+			result = append(result, execution)
+		}
+	}
+	return result, nil
+}
+
+// Update persists changes to an existing execution.
+func (r *MemoryReplicationExecutionRepository) Update(execution *entities.ReplicationExecution) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.executions[execution.ID]; !exists {
+		return domain.ErrReplicationExecutionNotFound
+	}
+	r.executions[execution.ID] = execution
+	return nil
+}
@@ -0,0 +1,68 @@
+package persistence
+
+import (
+	"context"
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// MemorySnapshotRepository is an in-memory implementation of SnapshotRepository
+type MemorySnapshotRepository struct {
+	manifests map[string]*entities.SnapshotManifest
+	mu        sync.RWMutex
+}
+
+// NewMemorySnapshotRepository creates a new MemorySnapshotRepository
+func NewMemorySnapshotRepository() *MemorySnapshotRepository {
+	return &MemorySnapshotRepository{
+		manifests: make(map[string]*entities.SnapshotManifest),
+	}
+}
+
+// Save persists a new snapshot manifest
+func (r *MemorySnapshotRepository) Save(manifest *entities.SnapshotManifest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.manifests[manifest.ID] = manifest
+	return nil
+}
+
+// FindByID retrieves a snapshot manifest by ID
+func (r *MemorySnapshotRepository) FindByID(id string) (*entities.SnapshotManifest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	manifest, exists := r.manifests[id]
+	if !exists {
+		return nil, domain.ErrSnapshotNotFound
+	}
+
+	return manifest, nil
+}
+
+// List returns a page of snapshot manifests, optionally restricted to
+// opts.TenantID, ordered by ID for a stable continuation token.
+func (r *MemorySnapshotRepository) List(ctx context.Context, opts repositories.ListOptions) ([]*entities.SnapshotManifest, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.manifests))
+	for id, manifest := range r.manifests {
+		if opts.TenantID != "" && manifest.TenantID != opts.TenantID {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	page, nextToken := paginateKeys(ids, opts.PageToken, opts.PageSize)
+
+	result := make([]*entities.SnapshotManifest, 0, len(page))
+	for _, id := range page {
+		result = append(result, r.manifests[id])
+	}
+	return result, nextToken, nil
+}
@@ -1,9 +1,13 @@
 package persistence
 
 import (
+	"context"
+	"errors"
+	"sync"
+
 	"github.com/allsource/control-plane/internal/domain"
 	"github.com/allsource/control-plane/internal/domain/entities"
-	"sync"
+	"github.com/allsource/control-plane/internal/domain/repositories"
 )
 
 // MemoryTenantRepository is an in-memory implementation of TenantRepository
@@ -28,11 +32,25 @@ func (r *MemoryTenantRepository) Save(tenant *entities.Tenant) error {
 	return nil
 }
 
-// FindByID retrieves a tenant by ID
+// FindByID retrieves a tenant by ID, excluding soft-deleted tenants
 func (r *MemoryTenantRepository) FindByID(id string) (*entities.Tenant, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	tenant, exists := r.tenants[id]
+	if !exists || tenant.IsDeleted() {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	return tenant, nil
+}
+
+// FindByIDIncludingDeleted retrieves a tenant by ID regardless of its
+// deletion status
+func (r *MemoryTenantRepository) FindByIDIncludingDeleted(id string) (*entities.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	tenant, exists := r.tenants[id]
 	if !exists {
 		return nil, domain.ErrTenantNotFound
@@ -41,19 +59,37 @@ func (r *MemoryTenantRepository) FindByID(id string) (*entities.Tenant, error) {
 	return tenant, nil
 }
 
-// FindAll retrieves all tenants
+// FindAll retrieves all tenants, excluding soft-deleted ones
 func (r *MemoryTenantRepository) FindAll() ([]*entities.Tenant, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	result := make([]*entities.Tenant, 0, len(r.tenants))
 	for _, tenant := range r.tenants {
+		if tenant.IsDeleted() {
+			continue
+		}
 		result = append(result, tenant)
 	}
 
 	return result, nil
 }
 
+// FindDeleted retrieves all soft-deleted tenants still awaiting purge
+func (r *MemoryTenantRepository) FindDeleted() ([]*entities.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entities.Tenant, 0)
+	for _, tenant := range r.tenants {
+		if tenant.IsDeleted() {
+			result = append(result, tenant)
+		}
+	}
+
+	return result, nil
+}
+
 // FindActive retrieves all active tenants
 func (r *MemoryTenantRepository) FindActive() ([]*entities.Tenant, error) {
 	r.mu.RLock()
@@ -82,24 +118,116 @@ func (r *MemoryTenantRepository) Update(tenant *entities.Tenant) error {
 	return nil
 }
 
-// Delete removes a tenant
+// Delete soft-deletes a tenant, starting its purge grace period rather
+// than removing it from the store immediately
 func (r *MemoryTenantRepository) Delete(id string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.tenants[id]; !exists {
+	tenant, exists := r.tenants[id]
+	if !exists {
+		return domain.ErrTenantNotFound
+	}
+
+	return tenant.MarkDeleted()
+}
+
+// Purge permanently removes a tenant that has already been soft-deleted
+func (r *MemoryTenantRepository) Purge(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenant, exists := r.tenants[id]
+	if !exists {
 		return domain.ErrTenantNotFound
 	}
+	if !tenant.IsDeleted() {
+		return errors.New("cannot purge a tenant that is not deleted")
+	}
 
 	delete(r.tenants, id)
 	return nil
 }
 
-// Exists checks if a tenant exists
+// Restore reactivates a soft-deleted tenant
+func (r *MemoryTenantRepository) Restore(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenant, exists := r.tenants[id]
+	if !exists {
+		return domain.ErrTenantNotFound
+	}
+
+	return tenant.Restore()
+}
+
+// Exists checks if a non-deleted tenant exists
 func (r *MemoryTenantRepository) Exists(id string) (bool, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	_, exists := r.tenants[id]
-	return exists, nil
+	tenant, exists := r.tenants[id]
+	return exists && !tenant.IsDeleted(), nil
+}
+
+// GuaranteedUpdate reads the current tenant, applies tryUpdate to a copy,
+// and CAS-writes the result back only if ResourceVersion still matches
+// what was read, retrying on conflict up to maxGuaranteedUpdateAttempts.
+func (r *MemoryTenantRepository) GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Tenant) (*entities.Tenant, error)) (*entities.Tenant, error) {
+	for attempt := 0; attempt < maxGuaranteedUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		current, exists := r.tenants[id]
+		if !exists {
+			r.mu.Unlock()
+			return nil, domain.ErrTenantNotFound
+		}
+		readVersion := current.ResourceVersion
+		currentCopy := *current
+
+		updated, err := tryUpdate(&currentCopy)
+		if err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+
+		if stored := r.tenants[id]; stored.ResourceVersion != readVersion {
+			r.mu.Unlock()
+			continue
+		}
+
+		updated.ResourceVersion = readVersion + 1
+		r.tenants[id] = updated
+		r.mu.Unlock()
+		return updated, nil
+	}
+
+	return nil, domain.ErrConflict
+}
+
+// List returns a page of non-deleted tenants ordered by ID for a stable
+// continuation token.
+func (r *MemoryTenantRepository) List(ctx context.Context, opts repositories.ListOptions) ([]*entities.Tenant, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.tenants))
+	for id, tenant := range r.tenants {
+		if tenant.IsDeleted() {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	page, nextToken := paginateKeys(ids, opts.PageToken, opts.PageSize)
+
+	result := make([]*entities.Tenant, 0, len(page))
+	for _, id := range page {
+		result = append(result, r.tenants[id])
+	}
+	return result, nextToken, nil
 }
@@ -1,9 +1,12 @@
 package persistence
 
 import (
+	"context"
+	"sync"
+
 	"github.com/allsource/control-plane/internal/domain"
 	"github.com/allsource/control-plane/internal/domain/entities"
-	"sync"
+	"github.com/allsource/control-plane/internal/domain/repositories"
 )
 
 // MemoryUserRepository is an in-memory implementation of UserRepository
@@ -109,3 +112,65 @@ func (r *MemoryUserRepository) Exists(id string) (bool, error) {
 	_, exists := r.users[id]
 	return exists, nil
 }
+
+// GuaranteedUpdate reads the current user, applies tryUpdate to a copy,
+// and CAS-writes the result back only if ResourceVersion still matches
+// what was read, retrying on conflict up to maxGuaranteedUpdateAttempts.
+func (r *MemoryUserRepository) GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.User) (*entities.User, error)) (*entities.User, error) {
+	for attempt := 0; attempt < maxGuaranteedUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		current, exists := r.users[id]
+		if !exists {
+			r.mu.Unlock()
+			return nil, domain.ErrUserNotFound
+		}
+		readVersion := current.ResourceVersion
+		currentCopy := *current
+
+		updated, err := tryUpdate(&currentCopy)
+		if err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+
+		if stored := r.users[id]; stored.ResourceVersion != readVersion {
+			r.mu.Unlock()
+			continue
+		}
+
+		updated.ResourceVersion = readVersion + 1
+		r.users[id] = updated
+		r.byUsername[updated.Username] = updated
+		r.mu.Unlock()
+		return updated, nil
+	}
+
+	return nil, domain.ErrConflict
+}
+
+// List returns a page of users, optionally filtered to opts.TenantID,
+// ordered by ID for a stable continuation token.
+func (r *MemoryUserRepository) List(ctx context.Context, opts repositories.ListOptions) ([]*entities.User, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.users))
+	for id, user := range r.users {
+		if opts.TenantID != "" && user.TenantID != opts.TenantID {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	page, nextToken := paginateKeys(ids, opts.PageToken, opts.PageSize)
+
+	result := make([]*entities.User, 0, len(page))
+	for _, id := range page {
+		result = append(result, r.users[id])
+	}
+	return result, nextToken, nil
+}
@@ -0,0 +1,341 @@
+package persistence
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// DefaultMerkleBatchSize is how many chained events a MerkleAuditRepository
+// groups into one checkpoint, when built with batchSize <= 0.
+const DefaultMerkleBatchSize = 1024
+
+// MerkleAuditRepository decorates an AuditRepository with a tamper-evident
+// hash chain — each event's Hash covers its own content plus the previous
+// event's Hash — and periodic Merkle checkpoints over batches of chained
+// events, so a tampered entry anywhere in a batch is detectable without
+// re-reading the whole log, and a single event's inclusion in a batch can
+// be proven to an external auditor that only holds that batch's signed
+// root. It wraps any AuditRepository, so the same tamper-evidence applies
+// whether the underlying store is in-memory, file-backed, or Postgres.
+type MerkleAuditRepository struct {
+	inner      repositories.AuditRepository
+	batchSize  int
+	signingKey ed25519.PrivateKey
+
+	mu          sync.Mutex
+	lastHash    []byte
+	chain       []*entities.AuditEvent
+	index       map[string]int
+	checkpoints []merkleCheckpoint
+}
+
+type merkleCheckpoint struct {
+	start     int
+	count     int
+	root      []byte
+	signature []byte
+}
+
+// NewMerkleAuditRepository wraps inner with hash chaining and Merkle
+// checkpointing. batchSize <= 0 uses DefaultMerkleBatchSize. signingKey
+// signs each checkpoint's Merkle root with Ed25519, so an external
+// auditor can verify it against the operator's known public key; a nil
+// signingKey leaves checkpoints unsigned.
+func NewMerkleAuditRepository(inner repositories.AuditRepository, batchSize int, signingKey ed25519.PrivateKey) *MerkleAuditRepository {
+	if batchSize <= 0 {
+		batchSize = DefaultMerkleBatchSize
+	}
+	return &MerkleAuditRepository{
+		inner:      inner,
+		batchSize:  batchSize,
+		signingKey: signingKey,
+		index:      make(map[string]int),
+	}
+}
+
+// Log chains event to the previously logged one under the write lock, so
+// concurrent writers can't race PrevHash/Hash out of order, then
+// delegates the (now-chained) event to inner. Closing a batch of
+// batchSize events triggers a new checkpoint.
+func (r *MerkleAuditRepository) Log(event *entities.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event.PrevHash = r.lastHash
+	hash, err := hashAuditEvent(event)
+	if err != nil {
+		return domain.Wrap(err, domain.CodeInternal, "failed to hash audit event")
+	}
+	event.Hash = hash
+
+	if err := r.inner.Log(event); err != nil {
+		return err
+	}
+
+	r.lastHash = hash
+	clone := *event
+	r.chain = append(r.chain, &clone)
+	if clone.ID != "" {
+		r.index[clone.ID] = len(r.chain) - 1
+	}
+	if len(r.chain)%r.batchSize == 0 {
+		r.checkpointLocked()
+	}
+	return nil
+}
+
+// FindByUser delegates to inner; tamper-evidence applies only to Log.
+func (r *MerkleAuditRepository) FindByUser(userID string, limit int) ([]*entities.AuditEvent, error) {
+	return r.inner.FindByUser(userID, limit)
+}
+
+// FindByTenant delegates to inner; tamper-evidence applies only to Log.
+func (r *MerkleAuditRepository) FindByTenant(tenantID string, limit int) ([]*entities.AuditEvent, error) {
+	return r.inner.FindByTenant(tenantID, limit)
+}
+
+// FindByTimeRange delegates to inner; tamper-evidence applies only to Log.
+func (r *MerkleAuditRepository) FindByTimeRange(start, end time.Time) ([]*entities.AuditEvent, error) {
+	return r.inner.FindByTimeRange(start, end)
+}
+
+// FindErrors delegates to inner; tamper-evidence applies only to Log.
+func (r *MerkleAuditRepository) FindErrors(limit int) ([]*entities.AuditEvent, error) {
+	return r.inner.FindErrors(limit)
+}
+
+// Query delegates to inner; tamper-evidence applies only to Log.
+func (r *MerkleAuditRepository) Query(q repositories.AuditQuery) (*repositories.AuditPage, error) {
+	return r.inner.Query(q)
+}
+
+// VerifyChain recomputes the hash chain over chain indices [start, end)
+// and returns a *domain.Error identifying the first entry whose Hash, or
+// whose PrevHash linkage to its predecessor, doesn't match what Log
+// computed, or nil if the whole range is intact.
+func (r *MerkleAuditRepository) VerifyChain(start, end int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if start < 0 || end > len(r.chain) || start > end {
+		return domain.Wrap(nil, domain.CodeValidationFailed,
+			fmt.Sprintf("verify chain: range [%d, %d) out of bounds for %d events", start, end, len(r.chain)))
+	}
+
+	for i := start; i < end; i++ {
+		event := r.chain[i]
+		if i > 0 && !bytes.Equal(event.PrevHash, r.chain[i-1].Hash) {
+			return tamperedAt(i)
+		}
+		want, err := hashAuditEvent(event)
+		if err != nil {
+			return domain.Wrap(err, domain.CodeInternal, "verify chain: failed to hash audit event")
+		}
+		if !bytes.Equal(want, event.Hash) {
+			return tamperedAt(i)
+		}
+	}
+	return nil
+}
+
+func tamperedAt(index int) error {
+	return domain.Wrap(nil, domain.CodeConflict, fmt.Sprintf("audit chain tampered at index %d", index))
+}
+
+// Checkpoint returns the most recently completed batch's Merkle root, the
+// number of events covered by that root, and (if this repository was
+// built with a signing key) an Ed25519 signature over the root. All
+// three are zero-valued if no batch has completed yet.
+func (r *MerkleAuditRepository) Checkpoint() (root []byte, count int, signature []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.checkpoints) == 0 {
+		return nil, 0, nil
+	}
+	cp := r.checkpoints[len(r.checkpoints)-1]
+	return cp.root, cp.count, cp.signature
+}
+
+// Prove returns the sibling-hash path proving eventID's inclusion in the
+// Merkle root of the batch it was checkpointed into, so an auditor
+// holding only that root can verify inclusion without the rest of the
+// log. It errors if eventID is unknown or hasn't been checkpointed yet.
+func (r *MerkleAuditRepository) Prove(eventID string) (MerkleProof, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	idx, ok := r.index[eventID]
+	if !ok {
+		return MerkleProof{}, domain.Wrap(nil, domain.CodeNotFound, fmt.Sprintf("no audit event with id %q", eventID))
+	}
+
+	cp, ok := r.checkpointForLocked(idx)
+	if !ok {
+		return MerkleProof{}, domain.Wrap(nil, domain.CodeNotFound, fmt.Sprintf("audit event %q has not been checkpointed yet", eventID))
+	}
+
+	leaves := make([][]byte, cp.count)
+	for i := 0; i < cp.count; i++ {
+		leaves[i] = r.chain[cp.start+i].Hash
+	}
+	siblings, leftSibling := merkleSiblingPath(leaves, idx-cp.start)
+
+	return MerkleProof{
+		EventID:     eventID,
+		LeafHash:    r.chain[idx].Hash,
+		Siblings:    siblings,
+		LeftSibling: leftSibling,
+		Root:        cp.root,
+	}, nil
+}
+
+// checkpointForLocked returns the checkpoint covering chain index idx.
+// Callers must hold r.mu.
+func (r *MerkleAuditRepository) checkpointForLocked(idx int) (merkleCheckpoint, bool) {
+	for _, cp := range r.checkpoints {
+		if idx >= cp.start && idx < cp.start+cp.count {
+			return cp, true
+		}
+	}
+	return merkleCheckpoint{}, false
+}
+
+// checkpointLocked builds a Merkle tree over the batch of events that was
+// just completed and, if signingKey is set, signs its root. Callers must
+// hold r.mu.
+func (r *MerkleAuditRepository) checkpointLocked() {
+	end := len(r.chain)
+	start := end - r.batchSize
+
+	leaves := make([][]byte, r.batchSize)
+	for i := 0; i < r.batchSize; i++ {
+		leaves[i] = r.chain[start+i].Hash
+	}
+	root := merkleRoot(leaves)
+
+	var signature []byte
+	if r.signingKey != nil {
+		signature = ed25519.Sign(r.signingKey, root)
+	}
+
+	r.checkpoints = append(r.checkpoints, merkleCheckpoint{
+		start:     start,
+		count:     r.batchSize,
+		root:      root,
+		signature: signature,
+	})
+}
+
+// MerkleProof is the sibling-hash path proving a single event's inclusion
+// in a checkpoint's Merkle root, letting an external auditor verify that
+// without holding any other event in the batch.
+type MerkleProof struct {
+	EventID  string
+	LeafHash []byte
+	Root     []byte
+
+	// Siblings[i] is the hash combined with the running hash at level i;
+	// LeftSibling[i] reports whether Siblings[i] goes on the left.
+	Siblings    [][]byte
+	LeftSibling []bool
+}
+
+// Verify recomputes the Merkle root from p's leaf hash and sibling path
+// and reports whether it matches p.Root.
+func (p MerkleProof) Verify() bool {
+	current := p.LeafHash
+	for i, sibling := range p.Siblings {
+		if p.LeftSibling[i] {
+			current = hashPair(sibling, current)
+		} else {
+			current = hashPair(current, sibling)
+		}
+	}
+	return bytes.Equal(current, p.Root)
+}
+
+// hashAuditEvent computes SHA256(canonical_json(event without PrevHash
+// and Hash) || event.PrevHash). encoding/json already emits map keys in
+// sorted order and struct fields in declaration order, so a plain
+// Marshal of the zeroed-out copy is canonical without a separate
+// canonicalization step.
+func hashAuditEvent(event *entities.AuditEvent) ([]byte, error) {
+	content := *event
+	prevHash := content.PrevHash
+	content.PrevHash = nil
+	content.Hash = nil
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	sum := sha256.Sum256(append(data, prevHash...))
+	return sum[:], nil
+}
+
+// merkleRoot computes the root of a binary Merkle tree over leaves,
+// duplicating the final leaf at any level with an odd count, so the tree
+// stays well-defined for any batch size.
+func merkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+	return level[0]
+}
+
+// merkleSiblingPath returns the sibling hash at each level of the tree
+// over leaves on the path from leaf index to the root, alongside whether
+// each sibling sits to the left of the running hash.
+func merkleSiblingPath(leaves [][]byte, index int) ([][]byte, []bool) {
+	var siblings [][]byte
+	var leftSibling []bool
+
+	level := leaves
+	for len(level) > 1 {
+		if index%2 == 0 {
+			siblingIdx := index + 1
+			if siblingIdx >= len(level) {
+				siblingIdx = index
+			}
+			siblings = append(siblings, level[siblingIdx])
+			leftSibling = append(leftSibling, false)
+		} else {
+			siblings = append(siblings, level[index-1])
+			leftSibling = append(leftSibling, true)
+		}
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+	return siblings, leftSibling
+}
+
+func nextMerkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		right := level[i]
+		if i+1 < len(level) {
+			right = level[i+1]
+		}
+		next = append(next, hashPair(level[i], right))
+	}
+	return next
+}
+
+func hashPair(left, right []byte) []byte {
+	sum := sha256.Sum256(append(append([]byte{}, left...), right...))
+	return sum[:]
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"sort"
+
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// maxGuaranteedUpdateAttempts bounds the compare-and-swap retry loop in
+// each repository's GuaranteedUpdate before it gives up with
+// domain.ErrConflict.
+const maxGuaranteedUpdateAttempts = 10
+
+// paginateKeys returns the page of keys starting just after pageToken, up
+// to pageSize long, plus the token to resume from for the next page
+// (empty once keys is exhausted). It backs every in-memory repository's
+// List method, which needs a stable order since Go map iteration isn't
+// one; keys is sorted in place.
+func paginateKeys(keys []string, pageToken string, pageSize int) (page []string, nextToken string) {
+	if pageSize <= 0 {
+		pageSize = repositories.DefaultListPageSize
+	}
+	sort.Strings(keys)
+
+	start := sort.SearchStrings(keys, pageToken)
+	if pageToken != "" && start < len(keys) && keys[start] == pageToken {
+		start++
+	}
+	if start > len(keys) {
+		start = len(keys)
+	}
+
+	end := start + pageSize
+	if end > len(keys) {
+		end = len(keys)
+	}
+
+	page = keys[start:end]
+	if end < len(keys) {
+		nextToken = keys[end-1]
+	}
+	return page, nextToken
+}
@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// defaultPoliciesYAML is MemoryPolicyRepository's and FilePolicyRepository's
+// built-in policy set, kept as data instead of Go code so operators can see
+// (and copy) exactly what a file-backed override needs to replace.
+//
+//go:embed default_policies.yaml
+var defaultPoliciesYAML []byte
+
+// policyFileSchema is the YAML/JSON shape a policy file parses into.
+// YAML is a superset of JSON, so the same schema and parser serve both.
+type policyFileSchema struct {
+	Policies []policyFileEntry `yaml:"policies"`
+}
+
+// policyFileEntry is one policy in a policyFileSchema.
+type policyFileEntry struct {
+	ID          string                `yaml:"id"`
+	Name        string                `yaml:"name"`
+	Description string                `yaml:"description"`
+	Resource    string                `yaml:"resource"`
+	Action      string                `yaml:"action"`
+	Priority    int                   `yaml:"priority"`
+	Enabled     bool                  `yaml:"enabled"`
+	Conditions  []policyFileCondition `yaml:"conditions"`
+}
+
+// policyFileCondition is one entry of policyFileEntry.Conditions.
+type policyFileCondition struct {
+	Field string      `yaml:"field"`
+	Op    string      `yaml:"op"`
+	Value interface{} `yaml:"value"`
+}
+
+// toPolicy builds the entities.Policy e describes.
+func (e policyFileEntry) toPolicy() (*entities.Policy, error) {
+	policy, err := entities.NewPolicy(e.ID, e.Name, e.Description, e.Resource, entities.PolicyAction(e.Action), e.Priority)
+	if err != nil {
+		return nil, err
+	}
+	policy.Enabled = e.Enabled
+
+	for _, cond := range e.Conditions {
+		if err := policy.AddCondition(cond.Field, cond.Op, cond.Value); err != nil {
+			return nil, fmt.Errorf("condition %q %q: %w", cond.Field, cond.Op, err)
+		}
+	}
+
+	return policy, nil
+}
+
+// parsePolicyFile parses data (YAML or JSON) into the entities.Policy
+// values it describes, keyed by ID.
+func parsePolicyFile(data []byte) (map[string]*entities.Policy, error) {
+	var schema policyFileSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parse policy file: %w", err)
+	}
+
+	policies := make(map[string]*entities.Policy, len(schema.Policies))
+	for _, entry := range schema.Policies {
+		policy, err := entry.toPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: %w", entry.ID, err)
+		}
+		policies[policy.ID] = policy
+	}
+	return policies, nil
+}
+
+// defaultPolicies parses the embedded default policy set. It panics on
+// error since defaultPoliciesYAML is compiled in and a parse failure there
+// is a bug in this package, not a runtime condition callers can recover
+// from.
+func defaultPolicies() map[string]*entities.Policy {
+	policies, err := parsePolicyFile(defaultPoliciesYAML)
+	if err != nil {
+		panic(fmt.Sprintf("persistence: embedded default policies: %v", err))
+	}
+	return policies
+}
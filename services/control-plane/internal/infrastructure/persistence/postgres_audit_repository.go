@@ -0,0 +1,285 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresAuditRepository is a pgx-backed implementation of
+// AuditRepository, for deployments that need audit events to survive a
+// control plane restart and support time-range/tenant/user queries
+// MemoryAuditRepository can't serve efficiently at scale.
+type PostgresAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresAuditRepository creates a PostgresAuditRepository. Callers
+// are expected to have already run the migration that creates the
+// `audit_events` table (see Migrate).
+func NewPostgresAuditRepository(pool *pgxpool.Pool) *PostgresAuditRepository {
+	return &PostgresAuditRepository{pool: pool}
+}
+
+// Migrate creates the table and indices PostgresAuditRepository depends
+// on if they don't already exist.
+func (r *PostgresAuditRepository) Migrate(ctx context.Context) error {
+	_, err := r.pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS audit_events (
+			id          BIGSERIAL PRIMARY KEY,
+			event_id    TEXT NOT NULL DEFAULT '',
+			timestamp   TIMESTAMPTZ NOT NULL,
+			event_type  TEXT NOT NULL,
+			user_id     TEXT NOT NULL DEFAULT '',
+			username    TEXT NOT NULL DEFAULT '',
+			tenant_id   TEXT NOT NULL DEFAULT '',
+			action      TEXT NOT NULL,
+			resource    TEXT NOT NULL DEFAULT '',
+			resource_id TEXT NOT NULL DEFAULT '',
+			method      TEXT NOT NULL DEFAULT '',
+			path        TEXT NOT NULL DEFAULT '',
+			status_code INTEGER NOT NULL DEFAULT 0,
+			duration_ms DOUBLE PRECISION NOT NULL DEFAULT 0,
+			ip_address  TEXT NOT NULL DEFAULT '',
+			user_agent  TEXT NOT NULL DEFAULT '',
+			error       TEXT NOT NULL DEFAULT '',
+			metadata    JSONB
+		);
+
+		ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS event_id TEXT NOT NULL DEFAULT '';
+
+		CREATE INDEX IF NOT EXISTS idx_audit_events_tenant_timestamp
+			ON audit_events (tenant_id, timestamp DESC);
+
+		CREATE INDEX IF NOT EXISTS idx_audit_events_user_timestamp
+			ON audit_events (user_id, timestamp DESC);
+
+		CREATE INDEX IF NOT EXISTS idx_audit_events_metadata_gin
+			ON audit_events USING GIN (metadata);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to migrate audit_events table: %w", err)
+	}
+	return nil
+}
+
+// Log persists an audit event.
+func (r *PostgresAuditRepository) Log(event *entities.AuditEvent) error {
+	ctx := context.Background()
+
+	metadata, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit event metadata: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO audit_events (
+			event_id, timestamp, event_type, user_id, username, tenant_id, action,
+			resource, resource_id, method, path, status_code, duration_ms,
+			ip_address, user_agent, error, metadata
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+	`,
+		event.ID, event.Timestamp, event.EventType, event.UserID, event.Username, event.TenantID, event.Action,
+		event.Resource, event.ResourceID, event.Method, event.Path, event.StatusCode, event.Duration,
+		event.IPAddress, event.UserAgent, event.Error, metadata,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit event: %w", err)
+	}
+	return nil
+}
+
+// FindByUser retrieves the most recent audit events for a specific user.
+func (r *PostgresAuditRepository) FindByUser(userID string, limit int) ([]*entities.AuditEvent, error) {
+	return r.query(`
+		SELECT event_id, timestamp, event_type, user_id, username, tenant_id, action,
+			resource, resource_id, method, path, status_code, duration_ms,
+			ip_address, user_agent, error, metadata
+		FROM audit_events WHERE user_id = $1
+		ORDER BY timestamp DESC LIMIT $2
+	`, userID, limit)
+}
+
+// FindByTenant retrieves the most recent audit events for a specific
+// tenant.
+func (r *PostgresAuditRepository) FindByTenant(tenantID string, limit int) ([]*entities.AuditEvent, error) {
+	return r.query(`
+		SELECT event_id, timestamp, event_type, user_id, username, tenant_id, action,
+			resource, resource_id, method, path, status_code, duration_ms,
+			ip_address, user_agent, error, metadata
+		FROM audit_events WHERE tenant_id = $1
+		ORDER BY timestamp DESC LIMIT $2
+	`, tenantID, limit)
+}
+
+// FindByTimeRange retrieves audit events within a time range.
+func (r *PostgresAuditRepository) FindByTimeRange(start, end time.Time) ([]*entities.AuditEvent, error) {
+	return r.query(`
+		SELECT event_id, timestamp, event_type, user_id, username, tenant_id, action,
+			resource, resource_id, method, path, status_code, duration_ms,
+			ip_address, user_agent, error, metadata
+		FROM audit_events WHERE timestamp > $1 AND timestamp < $2
+		ORDER BY timestamp DESC
+	`, start, end)
+}
+
+// FindErrors retrieves the most recent audit events that represent
+// errors.
+func (r *PostgresAuditRepository) FindErrors(limit int) ([]*entities.AuditEvent, error) {
+	return r.query(`
+		SELECT event_id, timestamp, event_type, user_id, username, tenant_id, action,
+			resource, resource_id, method, path, status_code, duration_ms,
+			ip_address, user_agent, error, metadata
+		FROM audit_events WHERE status_code >= 400 OR error <> ''
+		ORDER BY timestamp DESC LIMIT $1
+	`, limit)
+}
+
+// Query builds a dynamic SELECT over whichever AuditQuery filters are set,
+// using a (timestamp, event_id) keyset comparison for Cursor so paging
+// through a large result set doesn't need an OFFSET scan.
+func (r *PostgresAuditRepository) Query(q repositories.AuditQuery) (*repositories.AuditPage, error) {
+	q = q.Normalize()
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(cond, len(args)))
+	}
+
+	if q.UserID != "" {
+		addCond("user_id = $%d", q.UserID)
+	}
+	if q.TenantID != "" {
+		addCond("tenant_id = $%d", q.TenantID)
+	}
+	if q.Resource != "" {
+		addCond("resource = $%d", q.Resource)
+	}
+	if q.Action != "" {
+		addCond("action = $%d", q.Action)
+	}
+	if q.MinStatusCode != 0 {
+		addCond("status_code >= $%d", q.MinStatusCode)
+	}
+	if q.MaxStatusCode != 0 {
+		addCond("status_code <= $%d", q.MaxStatusCode)
+	}
+	if q.IsError != nil {
+		if *q.IsError {
+			conditions = append(conditions, "(status_code >= 400 OR error <> '')")
+		} else {
+			conditions = append(conditions, "(status_code < 400 AND error = '')")
+		}
+	}
+	if !q.Start.IsZero() {
+		addCond("timestamp >= $%d", q.Start)
+	}
+	if !q.End.IsZero() {
+		addCond("timestamp <= $%d", q.End)
+	}
+	if q.MetadataContains != "" {
+		addCond("metadata::text ILIKE $%d", "%"+q.MetadataContains+"%")
+	}
+
+	cmp, orderDir := "<", "DESC"
+	if q.SortOrder == repositories.SortAsc {
+		cmp, orderDir = ">", "ASC"
+	}
+	if q.Cursor != nil {
+		args = append(args, q.Cursor.LastTimestamp, q.Cursor.LastID)
+		conditions = append(conditions, fmt.Sprintf("(timestamp, event_id) %s ($%d, $%d)", cmp, len(args)-1, len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// Fetch one extra row so HasMore can be determined without a
+	// separate COUNT query.
+	args = append(args, q.PageSize+1)
+	sql := fmt.Sprintf(`
+		SELECT event_id, timestamp, event_type, user_id, username, tenant_id, action,
+			resource, resource_id, method, path, status_code, duration_ms,
+			ip_address, user_agent, error, metadata
+		FROM audit_events %s
+		ORDER BY timestamp %s, event_id %s
+		LIMIT $%d
+	`, where, orderDir, orderDir, len(args))
+
+	events, err := r.query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(events) > q.PageSize
+	if hasMore {
+		events = events[:q.PageSize]
+	}
+
+	nextCursor := ""
+	if hasMore && len(events) > 0 {
+		last := events[len(events)-1]
+		nextCursor = repositories.EncodeCursor(repositories.Cursor{LastTimestamp: last.Timestamp, LastID: last.ID})
+	}
+
+	return &repositories.AuditPage{Events: events, NextCursor: nextCursor, HasMore: hasMore}, nil
+}
+
+// query runs sql with args and scans every row into an AuditEvent.
+func (r *PostgresAuditRepository) query(sql string, args ...interface{}) ([]*entities.AuditEvent, error) {
+	ctx := context.Background()
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entities.AuditEvent
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit events: %w", err)
+	}
+	return events, nil
+}
+
+// scanAuditEvent scans one row in the column order every query method
+// above selects.
+func scanAuditEvent(row pgx.Row) (*entities.AuditEvent, error) {
+	var event entities.AuditEvent
+	var metadata []byte
+
+	err := row.Scan(
+		&event.ID, &event.Timestamp, &event.EventType, &event.UserID, &event.Username, &event.TenantID, &event.Action,
+		&event.Resource, &event.ResourceID, &event.Method, &event.Path, &event.StatusCode, &event.Duration,
+		&event.IPAddress, &event.UserAgent, &event.Error, &metadata,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan audit event: %w", err)
+	}
+
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &event.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to decode audit event metadata: %w", err)
+		}
+	}
+
+	return &event, nil
+}
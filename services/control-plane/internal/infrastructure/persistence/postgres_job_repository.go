@@ -0,0 +1,149 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresJobRepository is a pgx-backed implementation of JobRepository,
+// for deployments that need jobs to survive a control plane restart rather
+// than living only in MemoryJobRepository.
+type PostgresJobRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresJobRepository creates a PostgresJobRepository. Callers are
+// expected to have already run the migration that creates the `jobs`
+// table (see Migrate).
+func NewPostgresJobRepository(pool *pgxpool.Pool) *PostgresJobRepository {
+	return &PostgresJobRepository{pool: pool}
+}
+
+// Migrate creates the table PostgresJobRepository depends on if it
+// doesn't already exist.
+func (r *PostgresJobRepository) Migrate(ctx context.Context) error {
+	_, err := r.pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS jobs (
+			id       TEXT PRIMARY KEY,
+			document JSONB NOT NULL
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to migrate jobs table: %w", err)
+	}
+	return nil
+}
+
+func encodeJob(job *entities.Job) ([]byte, error) {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job: %w", err)
+	}
+	return data, nil
+}
+
+func decodeJob(data []byte) (*entities.Job, error) {
+	var job entities.Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("failed to decode job: %w", err)
+	}
+	return &job, nil
+}
+
+// Save persists a new job.
+func (r *PostgresJobRepository) Save(job *entities.Job) error {
+	ctx := context.Background()
+
+	doc, err := encodeJob(job)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO jobs (id, document)
+		VALUES ($1, $2)
+		ON CONFLICT (id) DO UPDATE SET document = $2
+	`, job.ID, doc)
+	if err != nil {
+		return fmt.Errorf("failed to upsert job: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a job by ID.
+func (r *PostgresJobRepository) FindByID(id string) (*entities.Job, error) {
+	ctx := context.Background()
+	var doc []byte
+	err := r.pool.QueryRow(ctx, `SELECT document FROM jobs WHERE id = $1`, id).Scan(&doc)
+	if err == pgx.ErrNoRows {
+		return nil, domain.ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job: %w", err)
+	}
+	return decodeJob(doc)
+}
+
+// FindAll retrieves every job.
+func (r *PostgresJobRepository) FindAll() ([]*entities.Job, error) {
+	ctx := context.Background()
+	rows, err := r.pool.Query(ctx, `SELECT document FROM jobs`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*entities.Job
+	for rows.Next() {
+		var doc []byte
+		if err := rows.Scan(&doc); err != nil {
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		job, err := decodeJob(doc)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// FindScheduled retrieves every job with a non-empty CronStr.
+func (r *PostgresJobRepository) FindScheduled() ([]*entities.Job, error) {
+	jobs, err := r.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*entities.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if job.CronStr != "" {
+			result = append(result, job)
+		}
+	}
+	return result, nil
+}
+
+// Update persists changes to an existing job.
+func (r *PostgresJobRepository) Update(job *entities.Job) error {
+	ctx := context.Background()
+
+	doc, err := encodeJob(job)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, `UPDATE jobs SET document = $2 WHERE id = $1`, job.ID, doc)
+	if err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrJobNotFound
+	}
+	return nil
+}
@@ -0,0 +1,536 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresPolicyRepository is a pgx-backed, versioned implementation of
+// VersionedPolicyRepository. Every Save/Update/Delete/Rollback writes a new
+// immutable row to policy_revisions keyed by (policy_id, revision) and
+// emits an AuditEvent through auditRepo, so policies survive restarts and
+// carry full change history.
+type PostgresPolicyRepository struct {
+	pool      *pgxpool.Pool
+	auditRepo repositories.AuditRepository
+}
+
+// NewPostgresPolicyRepository creates a PostgresPolicyRepository. Callers
+// are expected to have already run the migration that creates the
+// `policies` and `policy_revisions` tables (see Migrate).
+func NewPostgresPolicyRepository(pool *pgxpool.Pool, auditRepo repositories.AuditRepository) *PostgresPolicyRepository {
+	return &PostgresPolicyRepository{pool: pool, auditRepo: auditRepo}
+}
+
+// Migrate creates the tables PostgresPolicyRepository depends on if they
+// don't already exist.
+func (r *PostgresPolicyRepository) Migrate(ctx context.Context) error {
+	_, err := r.pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS policies (
+			id       TEXT PRIMARY KEY,
+			revision INTEGER NOT NULL,
+			document JSONB NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS policy_revisions (
+			policy_id   TEXT NOT NULL,
+			revision    INTEGER NOT NULL,
+			document    JSONB NOT NULL,
+			change_type TEXT NOT NULL,
+			actor       TEXT NOT NULL,
+			comment     TEXT NOT NULL DEFAULT '',
+			created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+			PRIMARY KEY (policy_id, revision)
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to migrate policy tables: %w", err)
+	}
+	return nil
+}
+
+func encodePolicy(policy *entities.Policy) ([]byte, error) {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode policy: %w", err)
+	}
+	return data, nil
+}
+
+func decodePolicy(data []byte) (*entities.Policy, error) {
+	var policy entities.Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to decode policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// nextRevision returns the revision number to use for the next write to
+// policyID, i.e. one past the highest revision currently recorded.
+func (r *PostgresPolicyRepository) nextRevision(ctx context.Context, tx pgx.Tx, policyID string) (int, error) {
+	var maxRev int
+	err := tx.QueryRow(ctx, `SELECT COALESCE(MAX(revision), 0) FROM policy_revisions WHERE policy_id = $1`, policyID).Scan(&maxRev)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read current revision: %w", err)
+	}
+	return maxRev + 1, nil
+}
+
+// writeRevision persists policy as the current version and appends an
+// immutable revision row, all within tx.
+func (r *PostgresPolicyRepository) writeRevision(ctx context.Context, tx pgx.Tx, policy *entities.Policy, changeType entities.PolicyChangeType, actor, comment string) (int, error) {
+	rev, err := r.nextRevision(ctx, tx, policy.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	doc, err := encodePolicy(policy)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO policies (id, revision, document)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO UPDATE SET revision = $2, document = $3
+	`, policy.ID, rev, doc)
+	if err != nil {
+		return 0, fmt.Errorf("failed to upsert policy: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO policy_revisions (policy_id, revision, document, change_type, actor, comment)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, policy.ID, rev, doc, string(changeType), actor, comment)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record policy revision: %w", err)
+	}
+
+	return rev, nil
+}
+
+func (r *PostgresPolicyRepository) emitAudit(operation, policyID, actor string, rev int) {
+	if r.auditRepo == nil {
+		return
+	}
+	event, err := entities.NewAuditEvent("policy."+operation, operation, "", "")
+	if err != nil {
+		return
+	}
+	event.WithResource("policy", policyID).WithUser(actor, actor)
+	event.AddMetadata("revision", rev)
+	_ = r.auditRepo.Log(event)
+}
+
+// Save persists a new policy as revision 1.
+func (r *PostgresPolicyRepository) Save(policy *entities.Policy) error {
+	return r.SaveAs(policy, "system", "initial creation")
+}
+
+// SaveAs is like Save but lets the caller attribute the creation to a
+// specific actor with a comment, which plain Save (required by
+// PolicyRepository) cannot express.
+func (r *PostgresPolicyRepository) SaveAs(policy *entities.Policy, actor, comment string) error {
+	_, err := r.SaveRevision(policy, entities.PolicyChangeCreate, actor, comment)
+	return err
+}
+
+// FindByID retrieves the current version of a policy.
+func (r *PostgresPolicyRepository) FindByID(id string) (*entities.Policy, error) {
+	ctx := context.Background()
+	var doc []byte
+	err := r.pool.QueryRow(ctx, `SELECT document FROM policies WHERE id = $1`, id).Scan(&doc)
+	if err == pgx.ErrNoRows {
+		return nil, domain.ErrPolicyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query policy: %w", err)
+	}
+	return decodePolicy(doc)
+}
+
+// FindAll retrieves the current version of every policy.
+func (r *PostgresPolicyRepository) FindAll() ([]*entities.Policy, error) {
+	ctx := context.Background()
+	rows, err := r.pool.Query(ctx, `SELECT document FROM policies`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []*entities.Policy
+	for rows.Next() {
+		var doc []byte
+		if err := rows.Scan(&doc); err != nil {
+			return nil, fmt.Errorf("failed to scan policy: %w", err)
+		}
+		policy, err := decodePolicy(doc)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, rows.Err()
+}
+
+// FindByResource retrieves the current version of policies for a resource.
+func (r *PostgresPolicyRepository) FindByResource(resource string) ([]*entities.Policy, error) {
+	policies, err := r.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*entities.Policy, 0, len(policies))
+	for _, p := range policies {
+		if p.Resource == resource {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// FindEnabled retrieves the current version of all enabled policies.
+func (r *PostgresPolicyRepository) FindEnabled() ([]*entities.Policy, error) {
+	policies, err := r.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*entities.Policy, 0, len(policies))
+	for _, p := range policies {
+		if p.Enabled {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// Update persists a new revision of an existing policy.
+func (r *PostgresPolicyRepository) Update(policy *entities.Policy) error {
+	if _, err := r.SaveRevision(policy, entities.PolicyChangeUpdate, "system", "update"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Delete removes the current version of a policy. The history in
+// policy_revisions is retained for audit purposes.
+func (r *PostgresPolicyRepository) Delete(id string) error {
+	ctx := context.Background()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	policy, err := r.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	rev, err := r.nextRevision(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	doc, err := encodePolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO policy_revisions (policy_id, revision, document, change_type, actor, comment)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, id, rev, doc, string(entities.PolicyChangeDelete), "system", "delete")
+	if err != nil {
+		return fmt.Errorf("failed to record delete revision: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM policies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPolicyNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit delete: %w", err)
+	}
+
+	r.emitAudit("delete", id, "system", rev)
+	return nil
+}
+
+// Exists checks whether a policy currently exists.
+func (r *PostgresPolicyRepository) Exists(id string) (bool, error) {
+	ctx := context.Background()
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM policies WHERE id = $1)`, id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check policy existence: %w", err)
+	}
+	return exists, nil
+}
+
+// GuaranteedUpdate performs an optimistic-concurrency update of policyID:
+// it locks and reads the current row, calls tryUpdate with a copy, and
+// persists the result as a new revision only if ResourceVersion still
+// matches what was read under the lock. SELECT ... FOR UPDATE means the
+// row lock, not a retry loop, is what serializes concurrent writers here;
+// the bounded loop exists so the method still honors
+// PolicyRepository.GuaranteedUpdate's contract if ResourceVersion ever
+// drifts from under the lock (e.g. a caller bypassing this repository).
+func (r *PostgresPolicyRepository) GuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Policy) (*entities.Policy, error)) (*entities.Policy, error) {
+	for attempt := 0; attempt < maxGuaranteedUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		updated, err := r.tryGuaranteedUpdate(ctx, id, tryUpdate)
+		if err == errResourceVersionConflict {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return updated, nil
+	}
+
+	return nil, domain.ErrConflict
+}
+
+// errResourceVersionConflict signals a ResourceVersion mismatch to
+// GuaranteedUpdate's retry loop; it never escapes this file.
+var errResourceVersionConflict = fmt.Errorf("resource version conflict")
+
+func (r *PostgresPolicyRepository) tryGuaranteedUpdate(ctx context.Context, id string, tryUpdate func(current *entities.Policy) (*entities.Policy, error)) (*entities.Policy, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var doc []byte
+	err = tx.QueryRow(ctx, `SELECT document FROM policies WHERE id = $1 FOR UPDATE`, id).Scan(&doc)
+	if err == pgx.ErrNoRows {
+		return nil, domain.ErrPolicyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query policy: %w", err)
+	}
+
+	current, err := decodePolicy(doc)
+	if err != nil {
+		return nil, err
+	}
+	readVersion := current.ResourceVersion
+
+	updated, err := tryUpdate(current)
+	if err != nil {
+		return nil, err
+	}
+
+	var storedVersion uint64
+	if err := tx.QueryRow(ctx, `SELECT (document->>'ResourceVersion')::bigint FROM policies WHERE id = $1`, id).Scan(&storedVersion); err != nil {
+		return nil, fmt.Errorf("failed to read current resource version: %w", err)
+	}
+	if storedVersion != readVersion {
+		return nil, errResourceVersionConflict
+	}
+	updated.ResourceVersion = readVersion + 1
+
+	rev, err := r.writeRevision(ctx, tx, updated, entities.PolicyChangeUpdate, "system", "guaranteed update")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit guaranteed update: %w", err)
+	}
+
+	r.emitAudit("update", id, "system", rev)
+	return updated, nil
+}
+
+// List returns a page of policies ordered by ID for a stable continuation
+// token.
+func (r *PostgresPolicyRepository) List(ctx context.Context, opts repositories.ListOptions) ([]*entities.Policy, string, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = repositories.DefaultListPageSize
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, document FROM policies
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`, opts.PageToken, pageSize)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to query policies: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		policies  []*entities.Policy
+		nextToken string
+	)
+	for rows.Next() {
+		var (
+			id  string
+			doc []byte
+		)
+		if err := rows.Scan(&id, &doc); err != nil {
+			return nil, "", fmt.Errorf("failed to scan policy: %w", err)
+		}
+		policy, err := decodePolicy(doc)
+		if err != nil {
+			return nil, "", err
+		}
+		policies = append(policies, policy)
+		nextToken = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(policies) < pageSize {
+		nextToken = ""
+	}
+	return policies, nextToken, nil
+}
+
+// SaveRevision writes policy as a new revision, attributing the change to
+// actor, and returns the revision number that was assigned.
+func (r *PostgresPolicyRepository) SaveRevision(policy *entities.Policy, changeType entities.PolicyChangeType, actor, comment string) (int, error) {
+	ctx := context.Background()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rev, err := r.writeRevision(ctx, tx, policy, changeType, actor, comment)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit revision: %w", err)
+	}
+
+	r.emitAudit(string(changeType), policy.ID, actor, rev)
+	return rev, nil
+}
+
+// ListRevisions returns every revision recorded for a policy, oldest first.
+func (r *PostgresPolicyRepository) ListRevisions(policyID string) ([]*entities.PolicyRevision, error) {
+	ctx := context.Background()
+	rows, err := r.pool.Query(ctx, `
+		SELECT revision, document, change_type, actor, comment, created_at
+		FROM policy_revisions
+		WHERE policy_id = $1
+		ORDER BY revision ASC
+	`, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query policy revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*entities.PolicyRevision
+	for rows.Next() {
+		rev, err := scanRevision(rows, policyID)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, rows.Err()
+}
+
+// GetRevision retrieves a single revision of a policy.
+func (r *PostgresPolicyRepository) GetRevision(policyID string, revision int) (*entities.PolicyRevision, error) {
+	ctx := context.Background()
+	row := r.pool.QueryRow(ctx, `
+		SELECT revision, document, change_type, actor, comment, created_at
+		FROM policy_revisions
+		WHERE policy_id = $1 AND revision = $2
+	`, policyID, revision)
+
+	rev, err := scanRevisionRow(row, policyID)
+	if err == pgx.ErrNoRows {
+		return nil, domain.ErrPolicyRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+// Rollback restores policyID to the state captured in revision, itself
+// recorded as a new revision attributed to actor.
+func (r *PostgresPolicyRepository) Rollback(policyID string, revision int, actor string) (*entities.Policy, error) {
+	target, err := r.GetRevision(policyID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := target.Policy
+	comment := fmt.Sprintf("rollback to revision %d", revision)
+	if _, err := r.SaveRevision(&restored, entities.PolicyChangeRollback, actor, comment); err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
+}
+
+// rowScanner abstracts over pgx.Rows and pgx.Row so scanRevision helpers
+// work for both ListRevisions (many rows) and GetRevision (one row).
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRevision(row rowScanner, policyID string) (*entities.PolicyRevision, error) {
+	return scanRevisionRow(row, policyID)
+}
+
+func scanRevisionRow(row rowScanner, policyID string) (*entities.PolicyRevision, error) {
+	var (
+		revision   int
+		doc        []byte
+		changeType string
+		actor      string
+		comment    string
+		createdAt  time.Time
+	)
+
+	if err := row.Scan(&revision, &doc, &changeType, &actor, &comment, &createdAt); err != nil {
+		return nil, err
+	}
+
+	policy, err := decodePolicy(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.PolicyRevision{
+		PolicyID:   policyID,
+		Revision:   revision,
+		Policy:     *policy,
+		ChangeType: entities.PolicyChangeType(changeType),
+		Actor:      actor,
+		Comment:    comment,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+var _ repositories.VersionedPolicyRepository = (*PostgresPolicyRepository)(nil)
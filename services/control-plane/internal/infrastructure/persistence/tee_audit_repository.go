@@ -0,0 +1,163 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// Defaults for TeeAuditRepository's async delivery pool.
+const (
+	DefaultTeeQueueSize = 1024
+	DefaultTeeWorkers   = 4
+)
+
+// TeeAuditRepository decorates an AuditRepository: every Log persists to
+// inner synchronously (so a slow or down sink never blocks or risks the
+// durable write), then fans the event out to every configured AuditSink
+// asynchronously via a bounded queue and a worker pool. Once the queue is
+// full, further events are dropped for delivery (not for persistence) and
+// counted, so an operator can alert on sink backpressure instead of the
+// request path stalling. It wraps any AuditRepository, so it composes with
+// MerkleAuditRepository (wrap the Tee around the Merkle repository to get
+// both tamper-evidence and sink fanout).
+type TeeAuditRepository struct {
+	inner repositories.AuditRepository
+	sinks []AuditSinkConfig
+
+	queue chan *entities.AuditEvent
+	wg    sync.WaitGroup
+
+	delivered atomic.Int64
+	dropped   atomic.Int64
+}
+
+// NewTeeAuditRepository wraps inner and starts workers goroutines (
+// DefaultTeeWorkers if <= 0) draining a queue of size queueSize
+// (DefaultTeeQueueSize if <= 0), fanning each logged event out to every
+// sink in sinks whose filters match.
+func NewTeeAuditRepository(inner repositories.AuditRepository, queueSize, workers int, sinks ...AuditSinkConfig) *TeeAuditRepository {
+	if queueSize <= 0 {
+		queueSize = DefaultTeeQueueSize
+	}
+	if workers <= 0 {
+		workers = DefaultTeeWorkers
+	}
+
+	t := &TeeAuditRepository{
+		inner: inner,
+		sinks: sinks,
+		queue: make(chan *entities.AuditEvent, queueSize),
+	}
+
+	t.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go t.run()
+	}
+	return t
+}
+
+func (t *TeeAuditRepository) run() {
+	defer t.wg.Done()
+	for event := range t.queue {
+		t.deliver(event)
+	}
+}
+
+func (t *TeeAuditRepository) deliver(event *entities.AuditEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, sink := range t.sinks {
+		if !sink.matches(event) {
+			continue
+		}
+		if err := sink.Sink.Emit(ctx, event); err != nil {
+			fmt.Fprintf(os.Stderr, "audit sink %s: %v\n", sink.Name, err)
+			continue
+		}
+		t.delivered.Add(1)
+	}
+}
+
+// Log persists event to inner, then enqueues it for sink fanout. A full
+// queue drops the event for sinks (incrementing Metrics' dropped count) but
+// never fails or delays the call; the durable write already succeeded.
+func (t *TeeAuditRepository) Log(event *entities.AuditEvent) error {
+	if err := t.inner.Log(event); err != nil {
+		return err
+	}
+
+	select {
+	case t.queue <- event:
+	default:
+		t.dropped.Add(1)
+	}
+	return nil
+}
+
+// FindByUser delegates to inner; sink fanout only applies to Log.
+func (t *TeeAuditRepository) FindByUser(userID string, limit int) ([]*entities.AuditEvent, error) {
+	return t.inner.FindByUser(userID, limit)
+}
+
+// FindByTenant delegates to inner; sink fanout only applies to Log.
+func (t *TeeAuditRepository) FindByTenant(tenantID string, limit int) ([]*entities.AuditEvent, error) {
+	return t.inner.FindByTenant(tenantID, limit)
+}
+
+// FindByTimeRange delegates to inner; sink fanout only applies to Log.
+func (t *TeeAuditRepository) FindByTimeRange(start, end time.Time) ([]*entities.AuditEvent, error) {
+	return t.inner.FindByTimeRange(start, end)
+}
+
+// FindErrors delegates to inner; sink fanout only applies to Log.
+func (t *TeeAuditRepository) FindErrors(limit int) ([]*entities.AuditEvent, error) {
+	return t.inner.FindErrors(limit)
+}
+
+// Query delegates to inner; sink fanout only applies to Log.
+func (t *TeeAuditRepository) Query(q repositories.AuditQuery) (*repositories.AuditPage, error) {
+	return t.inner.Query(q)
+}
+
+// Metrics reports how many sink deliveries have succeeded and how many
+// events were dropped for fanout because the queue was full, for wiring
+// into /metrics.
+func (t *TeeAuditRepository) Metrics() (delivered, dropped int64) {
+	return t.delivered.Load(), t.dropped.Load()
+}
+
+// Flush blocks until every sink has flushed any internally buffered
+// events. It does not drain the fanout queue first; call it only once Log
+// calls have quiesced.
+func (t *TeeAuditRepository) Flush() error {
+	var firstErr error
+	for _, sink := range t.sinks {
+		if err := sink.Sink.Flush(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Close drains whatever is already queued, stops the worker pool, then
+// closes every sink.
+func (t *TeeAuditRepository) Close() error {
+	close(t.queue)
+	t.wg.Wait()
+
+	var firstErr error
+	for _, sink := range t.sinks {
+		if err := sink.Sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
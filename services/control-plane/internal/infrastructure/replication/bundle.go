@@ -0,0 +1,105 @@
+// Package replication synchronizes a PolicyRepository across control-plane
+// replicas: Scheduler triggers a ReplicationPolicy on its cron schedule (or
+// on demand), Pusher signs the source's current policies into a Bundle and
+// pushes it to the ReplicationPolicy's target, and ResolveConflict decides
+// whether a receiving node should apply an incoming Bundle over whatever
+// it already has.
+package replication
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+// Bundle is a JSON, Ed25519-signed set of policies pushed from one
+// control-plane replica to another, this package's equivalent of the root
+// package's SignedBundle, carrying a per-policy monotonically increasing
+// Version and the pushing ReplicationPolicy's Priority so a receiving node
+// can resolve conflicting pushes (see ResolveConflict).
+type Bundle struct {
+	SourceResource string             `json:"source_resource"`
+	PolicyID       string             `json:"policy_id"`
+	Version        uint64             `json:"version"`
+	Priority       int                `json:"priority"`
+	Policies       []*entities.Policy `json:"policies"`
+	Signature      []byte             `json:"signature"`
+}
+
+// signingPayload returns the canonical bytes NewBundle signs and Verify
+// checks: every field but Signature itself, with Policies sorted by ID so
+// the payload doesn't depend on PolicyRepository.FindAll's iteration
+// order.
+func signingPayload(b Bundle) ([]byte, error) {
+	sorted := make([]*entities.Policy, len(b.Policies))
+	copy(sorted, b.Policies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	payload := struct {
+		SourceResource string             `json:"source_resource"`
+		PolicyID       string             `json:"policy_id"`
+		Version        uint64             `json:"version"`
+		Priority       int                `json:"priority"`
+		Policies       []*entities.Policy `json:"policies"`
+	}{b.SourceResource, b.PolicyID, b.Version, b.Priority, sorted}
+
+	return json.Marshal(payload)
+}
+
+// NewBundle builds and signs a Bundle of policies for the given replication
+// policy at the given version. A nil signingKey leaves Signature empty.
+func NewBundle(policyID string, version uint64, priority int, policies []*entities.Policy, signingKey ed25519.PrivateKey) (Bundle, error) {
+	bundle := Bundle{
+		SourceResource: entities.ReplicationSourcePolicies,
+		PolicyID:       policyID,
+		Version:        version,
+		Priority:       priority,
+		Policies:       policies,
+	}
+
+	payload, err := signingPayload(bundle)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("marshal replication bundle payload: %w", err)
+	}
+	if signingKey != nil {
+		bundle.Signature = ed25519.Sign(signingKey, payload)
+	}
+	return bundle, nil
+}
+
+// Verify reports whether b's signature verifies against at least one of
+// trustedKeys.
+func Verify(b Bundle, trustedKeys []ed25519.PublicKey) (bool, error) {
+	payload, err := signingPayload(b)
+	if err != nil {
+		return false, fmt.Errorf("marshal replication bundle payload: %w", err)
+	}
+	for _, key := range trustedKeys {
+		if ed25519.Verify(key, payload, b.Signature) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AppliedState records the version and priority of the last Bundle a node
+// applied for a given policy ID, so a later, conflicting push can be
+// compared against it.
+type AppliedState struct {
+	Version  uint64
+	Priority int
+}
+
+// ResolveConflict reports whether incoming should be applied over current:
+// a strictly higher Priority always wins; equal priority breaks on the
+// newer (larger) Version. A zero-value current (nothing applied yet)
+// always loses.
+func ResolveConflict(current AppliedState, incoming AppliedState) bool {
+	if incoming.Priority != current.Priority {
+		return incoming.Priority > current.Priority
+	}
+	return incoming.Version > current.Version
+}
@@ -0,0 +1,161 @@
+package replication
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultMaxPushAttempts is how many attempts Push makes in total, the
+// first one included, before giving up and leaving the last execution
+// failed.
+const DefaultMaxPushAttempts = 5
+
+// DefaultPushBackoff is the base delay Push's exponential backoff starts
+// from; attempt n waits DefaultPushBackoff*2^(n-1), capped at
+// DefaultMaxPushBackoff.
+const DefaultPushBackoff = 1 * time.Second
+
+// DefaultMaxPushBackoff caps the exponential backoff between push attempts.
+const DefaultMaxPushBackoff = 1 * time.Minute
+
+// IngestPath is the HTTP path a peer's replication ingest endpoint listens
+// on, relative to a ReplicationTarget's URL.
+const IngestPath = "/api/v1/replication/ingest"
+
+// Pusher pushes a ReplicationPolicy's source policies to its target,
+// retrying a failed push with exponential backoff and recording every
+// attempt as its own ReplicationExecution.
+type Pusher struct {
+	PolicyRepo     repositories.PolicyRepository
+	TargetRepo     repositories.ReplicationTargetRepository
+	ReplPolicyRepo repositories.ReplicationPolicyRepository
+	ExecutionRepo  repositories.ReplicationExecutionRepository
+	SigningKey     ed25519.PrivateKey
+
+	client *resty.Client
+}
+
+// NewPusher creates a Pusher. signingKey may be nil; Push still runs, but
+// the bundles it builds carry an empty Signature, so any properly
+// configured receiving peer rejects them until REPLICATION_SIGNING_KEY is
+// set.
+func NewPusher(
+	policyRepo repositories.PolicyRepository,
+	targetRepo repositories.ReplicationTargetRepository,
+	replPolicyRepo repositories.ReplicationPolicyRepository,
+	executionRepo repositories.ReplicationExecutionRepository,
+	signingKey ed25519.PrivateKey,
+) *Pusher {
+	return &Pusher{
+		PolicyRepo:     policyRepo,
+		TargetRepo:     targetRepo,
+		ReplPolicyRepo: replPolicyRepo,
+		ExecutionRepo:  executionRepo,
+		SigningKey:     signingKey,
+		client:         resty.New().SetTimeout(10 * time.Second),
+	}
+}
+
+// Push runs policy's replication once: it builds a signed bundle of the
+// source's current policies at the policy's next version and pushes it to
+// policy's target, making up to DefaultMaxPushAttempts attempts in all
+// with exponential backoff between them. It returns the execution recording the final
+// attempt's outcome.
+func (p *Pusher) Push(ctx context.Context, policy *entities.ReplicationPolicy) (*entities.ReplicationExecution, error) {
+	target, err := p.TargetRepo.FindByID(policy.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("push replication policy %s: %w", policy.ID, err)
+	}
+	if !target.Enabled {
+		return nil, fmt.Errorf("push replication policy %s: target %s is disabled", policy.ID, target.ID)
+	}
+
+	version, err := p.ReplPolicyRepo.NextVersion(policy.ID)
+	if err != nil {
+		return nil, fmt.Errorf("push replication policy %s: %w", policy.ID, err)
+	}
+
+	policies, err := p.PolicyRepo.FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("push replication policy %s: %w", policy.ID, err)
+	}
+
+	bundle, err := NewBundle(policy.ID, version, policy.Priority, policies, p.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("push replication policy %s: %w", policy.ID, err)
+	}
+
+	execution := entities.NewReplicationExecution(policy.ID, target.ID, version, 1)
+	if err := p.ExecutionRepo.Save(execution); err != nil {
+		return nil, fmt.Errorf("push replication policy %s: %w", policy.ID, err)
+	}
+
+	var pushErr error
+	for attempt := 1; attempt <= DefaultMaxPushAttempts; attempt++ {
+		_ = execution.MarkRunning()
+		_ = p.ExecutionRepo.Update(execution)
+
+		pushErr = p.attempt(target, bundle)
+		if pushErr == nil {
+			_ = execution.MarkSucceeded()
+			_ = p.ExecutionRepo.Update(execution)
+			return execution, nil
+		}
+
+		_ = execution.MarkFailed(pushErr)
+		_ = p.ExecutionRepo.Update(execution)
+
+		if attempt == DefaultMaxPushAttempts {
+			break
+		}
+		fmt.Fprintf(os.Stderr, "replication pusher: attempt %d/%d for policy %s failed: %v\n", attempt, DefaultMaxPushAttempts, policy.ID, pushErr)
+
+		select {
+		case <-ctx.Done():
+			return execution, ctx.Err()
+		case <-time.After(backoffDelay(attempt)):
+		}
+
+		next := entities.NewReplicationExecution(policy.ID, target.ID, version, attempt+1)
+		if err := p.ExecutionRepo.Save(next); err != nil {
+			return execution, fmt.Errorf("push replication policy %s: %w", policy.ID, err)
+		}
+		execution = next
+	}
+
+	return execution, fmt.Errorf("push replication policy %s to target %s: %w", policy.ID, target.ID, pushErr)
+}
+
+// attempt performs a single HTTP push of bundle to target's ingest
+// endpoint.
+func (p *Pusher) attempt(target *entities.ReplicationTarget, bundle Bundle) error {
+	resp, err := p.client.R().
+		SetAuthToken(target.Credentials).
+		SetBody(bundle).
+		Post(target.URL + IngestPath)
+	if err != nil {
+		return fmt.Errorf("push to %s: %w", target.URL, err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("push to %s: status %d: %s", target.URL, resp.StatusCode(), resp.String())
+	}
+	return nil
+}
+
+// backoffDelay returns the exponential backoff delay before the attempt
+// after attempt, capped at DefaultMaxPushBackoff.
+func backoffDelay(attempt int) time.Duration {
+	delay := DefaultPushBackoff * time.Duration(math.Pow(2, float64(attempt-1)))
+	if delay > DefaultMaxPushBackoff {
+		return DefaultMaxPushBackoff
+	}
+	return delay
+}
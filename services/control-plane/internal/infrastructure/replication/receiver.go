@@ -0,0 +1,91 @@
+package replication
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"sync"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// Receiver applies Bundles pushed by a peer's Pusher to the local
+// PolicyRepository, verifying each bundle's signature and resolving
+// conflicts against whatever this node last applied for the same
+// replication policy (see ResolveConflict).
+type Receiver struct {
+	policyRepo  repositories.PolicyRepository
+	trustedKeys []ed25519.PublicKey
+
+	mu      sync.Mutex
+	applied map[string]AppliedState // policy ID -> last applied state
+}
+
+// NewReceiver creates a Receiver. An empty trustedKeys rejects every
+// bundle, leaving the ingest endpoint effectively disabled until
+// REPLICATION_TRUSTED_KEYS is set.
+func NewReceiver(policyRepo repositories.PolicyRepository, trustedKeys []ed25519.PublicKey) *Receiver {
+	return &Receiver{
+		policyRepo:  policyRepo,
+		trustedKeys: trustedKeys,
+		applied:     make(map[string]AppliedState),
+	}
+}
+
+// Apply verifies bundle against the receiver's trusted keys and, if it
+// wins the conflict against whatever was last applied for bundle.PolicyID
+// (see ResolveConflict), replaces the local PolicyRepository's contents
+// with bundle.Policies. It returns false, with no error, if bundle is
+// authentic but loses the conflict and is accepted but dropped.
+func (r *Receiver) Apply(bundle Bundle) (bool, error) {
+	if len(r.trustedKeys) == 0 {
+		return false, fmt.Errorf("replication receiver: no trusted keys configured")
+	}
+
+	ok, err := Verify(bundle, r.trustedKeys)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, fmt.Errorf("replication bundle: signature does not match any trusted key")
+	}
+
+	incoming := AppliedState{Version: bundle.Version, Priority: bundle.Priority}
+
+	r.mu.Lock()
+	current := r.applied[bundle.PolicyID]
+	if !ResolveConflict(current, incoming) {
+		r.mu.Unlock()
+		return false, nil
+	}
+	r.applied[bundle.PolicyID] = incoming
+	r.mu.Unlock()
+
+	if err := r.replacePolicies(bundle.Policies); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// replacePolicies swaps the repository's contents for policies: every
+// existing policy is deleted, then every incoming policy is saved. The
+// swap is not atomic: readers can see the repository part-way through,
+// and an error midway leaves it partly replaced. This mirrors the root package's PolicyEngine.LoadSignedBundle
+// replace semantics for its own, separate bundle format.
+func (r *Receiver) replacePolicies(policies []*entities.Policy) error {
+	existing, err := r.policyRepo.FindAll()
+	if err != nil {
+		return fmt.Errorf("replication receiver: list existing policies: %w", err)
+	}
+	for _, policy := range existing {
+		if err := r.policyRepo.Delete(policy.ID); err != nil {
+			return fmt.Errorf("replication receiver: delete policy %s: %w", policy.ID, err)
+		}
+	}
+	for _, policy := range policies {
+		if err := r.policyRepo.Save(policy); err != nil {
+			return fmt.Errorf("replication receiver: save policy %s: %w", policy.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package replication
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/robfig/cron/v3"
+)
+
+// DefaultScheduleRescanInterval is how often Scheduler re-reads
+// ReplicationPolicyRepository for policies created, enabled, disabled, or
+// rescheduled since its last scan.
+const DefaultScheduleRescanInterval = 1 * time.Minute
+
+// Scheduler triggers each enabled, cron-scheduled ReplicationPolicy's push
+// on its own schedule. Unlike workers.JobDispatcher's CronStr (a fixed Go
+// duration), ReplicationPolicy.CronStr is a full 5-field cron expression,
+// since replicating to a target commonly wants an arbitrary schedule
+// (e.g. "0 */6 * * *") rather than just an interval; this package depends
+// on robfig/cron for the parsing and triggering workers.JobDispatcher
+// doesn't need.
+type Scheduler struct {
+	policyRepo repositories.ReplicationPolicyRepository
+	pusher     *Pusher
+	cron       *cron.Cron
+
+	mu      sync.Mutex
+	entries map[string]cron.EntryID // policy ID -> registered cron entry
+}
+
+// NewScheduler creates a Scheduler that triggers pusher against every
+// policy policyRepo.FindScheduled returns.
+func NewScheduler(policyRepo repositories.ReplicationPolicyRepository, pusher *Pusher) *Scheduler {
+	return &Scheduler{
+		policyRepo: policyRepo,
+		pusher:     pusher,
+		cron:       cron.New(),
+		entries:    make(map[string]cron.EntryID),
+	}
+}
+
+// Run starts the cron runner and periodically rescans policyRepo for
+// policies to add, update, or remove; it blocks until ctx is cancelled,
+// then stops the cron runner and waits for any in-flight push to finish.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.rescan(ctx)
+	s.cron.Start()
+
+	ticker := time.NewTicker(DefaultScheduleRescanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			<-s.cron.Stop().Done()
+			return
+		case <-ticker.C:
+			s.rescan(ctx)
+		}
+	}
+}
+
+// TriggerNow runs policy's push immediately, outside its cron schedule,
+// for a manually-triggered replication run.
+func (s *Scheduler) TriggerNow(ctx context.Context, policy *entities.ReplicationPolicy) (*entities.ReplicationExecution, error) {
+	return s.pusher.Push(ctx, policy)
+}
+
+// rescan registers every enabled, scheduled policy not yet tracked,
+// re-registers one whose CronStr changed, and removes entries for
+// policies that were disabled, rescheduled to manual-only, or deleted.
+func (s *Scheduler) rescan(ctx context.Context) {
+	policies, err := s.policyRepo.FindScheduled()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replication scheduler: list scheduled policies: %v\n", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		seen[policy.ID] = true
+		s.registerLocked(ctx, policy)
+	}
+
+	for id, entryID := range s.entries {
+		if !seen[id] {
+			s.cron.Remove(entryID)
+			delete(s.entries, id)
+		}
+	}
+}
+
+// registerLocked adds (or re-adds, if already present) policy's push to
+// the cron runner under its CronStr. Callers must hold s.mu. A policy with
+// an invalid CronStr is skipped with a warning rather than failing the
+// whole rescan.
+func (s *Scheduler) registerLocked(ctx context.Context, policy *entities.ReplicationPolicy) {
+	if entryID, ok := s.entries[policy.ID]; ok {
+		s.cron.Remove(entryID)
+	}
+
+	p := policy
+	entryID, err := s.cron.AddFunc(p.CronStr, func() {
+		if _, err := s.pusher.Push(ctx, p); err != nil {
+			fmt.Fprintf(os.Stderr, "replication scheduler: push policy %s: %v\n", p.ID, err)
+		}
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replication scheduler: invalid cron_str %q for policy %s: %v\n", p.CronStr, p.ID, err)
+		delete(s.entries, p.ID)
+		return
+	}
+	s.entries[p.ID] = entryID
+}
@@ -0,0 +1,72 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain"
+)
+
+// LocalStore is a filesystem-backed Store, used in place of S3Store for
+// local development and tests.
+type LocalStore struct {
+	baseDir string
+}
+
+// NewLocalStore creates a LocalStore rooted at baseDir. It does not create
+// baseDir; Put creates the directories it writes into.
+func NewLocalStore(baseDir string) *LocalStore {
+	return &LocalStore{baseDir: baseDir}
+}
+
+func (s *LocalStore) path(tenantID, snapshotID string) string {
+	return filepath.Join(s.baseDir, filepath.FromSlash(artifactKey(tenantID, snapshotID)))
+}
+
+// Put writes r to disk under baseDir, computing its SHA-256 as it streams.
+func (s *LocalStore) Put(ctx context.Context, tenantID, snapshotID string, r io.Reader) (int64, string, error) {
+	path := s.path(tenantID, snapshotID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, "", fmt.Errorf("create snapshot directory: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, "", fmt.Errorf("create snapshot file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(f, io.TeeReader(r, h))
+	if err != nil {
+		return 0, "", fmt.Errorf("write snapshot file: %w", err)
+	}
+
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Get opens the artifact for streaming. The caller must Close it.
+func (s *LocalStore) Get(ctx context.Context, tenantID, snapshotID string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(tenantID, snapshotID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, domain.ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot file: %w", err)
+	}
+	return f, nil
+}
+
+// DownloadURL always returns "": a local file has no URL a remote client
+// could fetch it from, so callers fall back to Get and stream it through
+// the control plane itself.
+func (s *LocalStore) DownloadURL(ctx context.Context, tenantID, snapshotID string, expiry time.Duration) (string, error) {
+	return "", nil
+}
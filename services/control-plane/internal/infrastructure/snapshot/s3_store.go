@@ -0,0 +1,93 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Store is an S3-compatible Store: every snapshot lands at
+// s3://bucket/{prefix}{tenant}/{snapshot_id}.jsonl.gz.
+type S3Store struct {
+	client        *s3.Client
+	presignClient *s3.PresignClient
+	bucket        string
+	prefix        string
+}
+
+// NewS3Store creates an S3Store that uploads to bucket, optionally under
+// prefix (e.g. "snapshots/").
+func NewS3Store(client *s3.Client, bucket, prefix string) *S3Store {
+	return &S3Store{
+		client:        client,
+		presignClient: s3.NewPresignClient(client),
+		bucket:        bucket,
+		prefix:        prefix,
+	}
+}
+
+func (s *S3Store) key(tenantID, snapshotID string) string {
+	return s.prefix + artifactKey(tenantID, snapshotID)
+}
+
+// Put buffers r in memory (snapshot artifacts are expected to fit
+// comfortably there, same tradeoff S3AuditSink makes for audit segments),
+// computing its SHA-256 as it buffers, then uploads the result in a
+// single PutObject call.
+func (s *S3Store) Put(ctx context.Context, tenantID, snapshotID string, r io.Reader) (int64, string, error) {
+	var buf bytes.Buffer
+	h := sha256.New()
+	n, err := io.Copy(&buf, io.TeeReader(r, h))
+	if err != nil {
+		return 0, "", fmt.Errorf("buffer snapshot upload: %w", err)
+	}
+
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(tenantID, snapshotID)),
+		Body:   bytes.NewReader(buf.Bytes()),
+	})
+	if err != nil {
+		return 0, "", fmt.Errorf("upload snapshot to s3: %w", err)
+	}
+
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Get streams the artifact directly from S3. The caller must Close it.
+func (s *S3Store) Get(ctx context.Context, tenantID, snapshotID string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(tenantID, snapshotID)),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, domain.ErrSnapshotNotFound
+		}
+		return nil, fmt.Errorf("get snapshot from s3: %w", err)
+	}
+	return out.Body, nil
+}
+
+// DownloadURL returns a presigned GET URL valid for expiry.
+func (s *S3Store) DownloadURL(ctx context.Context, tenantID, snapshotID string, expiry time.Duration) (string, error) {
+	req, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(tenantID, snapshotID)),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", fmt.Errorf("presign snapshot download: %w", err)
+	}
+	return req.URL, nil
+}
@@ -0,0 +1,37 @@
+// Package snapshot provides pluggable storage backends for durable
+// snapshot artifacts, keyed by tenant and snapshot ID.
+package snapshot
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Store is a pluggable backend for snapshot artifacts. Implementations:
+// S3Store (production, S3-compatible object storage) and LocalStore
+// (local filesystem, for tests and deployments without S3 configured).
+type Store interface {
+	// Put streams r to the artifact identified by (tenantID, snapshotID),
+	// returning its size in bytes and hex-encoded SHA-256 once it has
+	// been fully written.
+	Put(ctx context.Context, tenantID, snapshotID string, r io.Reader) (sizeBytes int64, sha256Hex string, err error)
+
+	// Get opens the artifact identified by (tenantID, snapshotID) for
+	// streaming. The caller must Close the returned reader. Returns
+	// domain.ErrSnapshotNotFound if no such artifact exists.
+	Get(ctx context.Context, tenantID, snapshotID string) (io.ReadCloser, error)
+
+	// DownloadURL returns a pre-authorized URL a client can fetch the
+	// artifact from directly, or "" if the backend has no such concept
+	// (e.g. LocalStore), in which case the caller should fall back to
+	// Get.
+	DownloadURL(ctx context.Context, tenantID, snapshotID string, expiry time.Duration) (string, error)
+}
+
+// artifactKey is the store key a (tenantID, snapshotID) pair maps to,
+// shared by every Store implementation so a snapshot lands at the same
+// relative path regardless of backend.
+func artifactKey(tenantID, snapshotID string) string {
+	return tenantID + "/" + snapshotID + ".jsonl.gz"
+}
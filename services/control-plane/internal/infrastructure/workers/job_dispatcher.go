@@ -0,0 +1,304 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans JobDispatcher opens around each
+// execution, distinct from the HTTP-request tracer TracingMiddleware uses.
+const tracerName = "allsource-control-plane/job-dispatcher"
+
+// executionContextKey is the context key execute() stores the current
+// JobExecution under, so a JobHandler can record richer output (e.g.
+// runPolicyEvaluationJob's report) than the plain error MarkFailed takes.
+type executionContextKey struct{}
+
+// ExecutionFromContext returns the JobExecution JobDispatcher created for
+// the in-flight execute() call, if executionRepo was configured.
+func ExecutionFromContext(ctx context.Context) (*entities.JobExecution, bool) {
+	execution, ok := ctx.Value(executionContextKey{}).(*entities.JobExecution)
+	return execution, ok
+}
+
+// DefaultJobWorkers is how many concurrent workers a JobDispatcher runs
+// when constructed with a zero or negative worker count.
+const DefaultJobWorkers = 4
+
+// DefaultJobScheduleInterval is how often JobDispatcher scans for
+// cron-scheduled jobs that have come due.
+const DefaultJobScheduleInterval = 1 * time.Minute
+
+// jobQueueSize bounds how many pending jobs JobDispatcher buffers before
+// Enqueue blocks the caller.
+const jobQueueSize = 256
+
+// JobHandler executes a single Job's work. Returning an error marks the
+// job failed; returning nil marks it completed.
+type JobHandler func(ctx context.Context, job *entities.Job) error
+
+// JobDispatcher is a fixed-size worker pool that executes Jobs pulled off
+// an internal queue, dispatching each to the JobHandler registered for its
+// Type. Workers persist a job's state through JobRepository as it moves
+// from pending to running to a terminal state, and a separate loop
+// re-triggers jobs whose CronStr interval has elapsed.
+type JobDispatcher struct {
+	jobRepo       repositories.JobRepository
+	auditRepo     repositories.AuditRepository
+	executionRepo repositories.JobExecutionRepository
+	handlers      map[entities.JobType]JobHandler
+
+	queue   chan *entities.Job
+	workers int
+
+	mu       sync.Mutex
+	canceled map[string]bool
+}
+
+// NewJobDispatcher creates a JobDispatcher with the given number of
+// workers (DefaultJobWorkers if zero or negative). executionRepo may be
+// nil, in which case executions aren't recorded and handlers never find
+// one via ExecutionFromContext.
+func NewJobDispatcher(jobRepo repositories.JobRepository, auditRepo repositories.AuditRepository, executionRepo repositories.JobExecutionRepository, workers int) *JobDispatcher {
+	if workers <= 0 {
+		workers = DefaultJobWorkers
+	}
+	return &JobDispatcher{
+		jobRepo:       jobRepo,
+		auditRepo:     auditRepo,
+		executionRepo: executionRepo,
+		handlers:      make(map[entities.JobType]JobHandler),
+		queue:         make(chan *entities.Job, jobQueueSize),
+		workers:       workers,
+		canceled:      make(map[string]bool),
+	}
+}
+
+// Register associates a JobHandler with a JobType. A job enqueued with no
+// registered handler for its type fails immediately when a worker picks
+// it up.
+func (d *JobDispatcher) Register(jobType entities.JobType, handler JobHandler) {
+	d.handlers[jobType] = handler
+}
+
+// Enqueue submits a pending job for execution by the worker pool.
+func (d *JobDispatcher) Enqueue(job *entities.Job) {
+	d.queue <- job
+}
+
+// Cancel marks job canceled. A job already picked up by a worker runs to
+// completion regardless; Cancel only prevents a still-pending job from
+// starting.
+func (d *JobDispatcher) Cancel(job *entities.Job) error {
+	if err := job.Cancel(); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.canceled[job.ID] = true
+	d.mu.Unlock()
+
+	return d.jobRepo.Update(job)
+}
+
+// Run starts the worker pool and the cron scheduling loop; it blocks until
+// ctx is cancelled, then waits for in-flight jobs to finish.
+func (d *JobDispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(ctx)
+		}()
+	}
+
+	go d.scheduleLoop(ctx)
+
+	<-ctx.Done()
+	wg.Wait()
+}
+
+// work pulls jobs off the queue until ctx is cancelled, executing each
+// with the handler registered for its type.
+func (d *JobDispatcher) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-d.queue:
+			d.execute(ctx, job)
+		}
+	}
+}
+
+// execute runs a single job end-to-end: marks it running, invokes its
+// handler inside its own execution span, records the terminal state, and
+// emits an audit event.
+func (d *JobDispatcher) execute(ctx context.Context, job *entities.Job) {
+	if d.isCanceled(job.ID) {
+		return
+	}
+
+	if err := job.MarkRunning(); err != nil {
+		fmt.Fprintf(os.Stderr, "job dispatcher: %s: %v\n", job.ID, err)
+		return
+	}
+	_ = d.jobRepo.Update(job)
+
+	ctx, execution, span := d.beginExecution(ctx, job)
+	defer span.End()
+
+	handler, ok := d.handlers[job.Type]
+	if !ok {
+		err := fmt.Errorf("no handler registered for job type %q", job.Type)
+		_ = job.MarkFailed(err)
+		_ = d.jobRepo.Update(job)
+		d.finishExecution(execution, span, err)
+		d.audit(job)
+		return
+	}
+
+	err := handler(ctx, job)
+	if err != nil {
+		_ = job.MarkFailed(err)
+	} else {
+		_ = job.MarkCompleted()
+	}
+	_ = d.jobRepo.Update(job)
+	d.finishExecution(execution, span, err)
+	d.audit(job)
+}
+
+// beginExecution opens an OpenTelemetry span for job's run and, if
+// executionRepo is configured, a pending JobExecution recording the
+// span's trace ID for later correlation in Jaeger. The returned context
+// carries both the span and (via ExecutionFromContext) the execution, so
+// handlers can record their own stdout/stderr before execute finishes it.
+func (d *JobDispatcher) beginExecution(ctx context.Context, job *entities.Job) (context.Context, *entities.JobExecution, trace.Span) {
+	tracer := otel.Tracer(tracerName)
+	ctx, span := tracer.Start(ctx, "job.execute", trace.WithAttributes(
+		attribute.String("job.id", job.ID),
+		attribute.String("job.type", string(job.Type)),
+	))
+
+	if d.executionRepo == nil {
+		return ctx, nil, span
+	}
+
+	execution := entities.NewJobExecution(job.ID)
+	execution.TraceID = span.SpanContext().TraceID().String()
+	_ = execution.MarkRunning()
+	if err := d.executionRepo.Save(execution); err != nil {
+		fmt.Fprintf(os.Stderr, "job dispatcher: save execution for %s: %v\n", job.ID, err)
+	}
+
+	ctx = context.WithValue(ctx, executionContextKey{}, execution)
+	return ctx, execution, span
+}
+
+// finishExecution records handlerErr on span and, if execution is
+// non-nil, transitions it to its terminal state and persists it. A
+// handler that populated execution.Stdout via ExecutionFromContext keeps
+// that report; a failure instead records handlerErr's message as stderr.
+func (d *JobDispatcher) finishExecution(execution *entities.JobExecution, span trace.Span, handlerErr error) {
+	if handlerErr != nil {
+		span.RecordError(handlerErr)
+		span.SetAttributes(attribute.Bool("error", true))
+	}
+
+	if execution == nil {
+		return
+	}
+	if handlerErr != nil {
+		_ = execution.MarkFailed(handlerErr.Error(), 1)
+	} else {
+		_ = execution.MarkSucceeded(execution.Stdout)
+	}
+	if err := d.executionRepo.Update(execution); err != nil {
+		fmt.Fprintf(os.Stderr, "job dispatcher: update execution %s: %v\n", execution.ID, err)
+	}
+}
+
+// audit records a job's terminal state as an AuditEvent, mirroring
+// TenantPurgeWorker's best-effort "log it, don't fail the operation" style.
+func (d *JobDispatcher) audit(job *entities.Job) {
+	if d.auditRepo == nil {
+		return
+	}
+	event, err := entities.NewAuditEvent("job."+string(job.Status), string(job.Status), "WORKER", "/jobs/"+job.ID)
+	if err != nil {
+		return
+	}
+	event.WithResource("job", job.ID).WithUser(job.TriggeredBy, job.TriggeredBy)
+	if job.Error != "" {
+		event.WithError(job.Error)
+	}
+	_ = d.auditRepo.Log(event)
+}
+
+func (d *JobDispatcher) isCanceled(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.canceled[id]
+}
+
+// scheduleLoop periodically re-triggers jobs whose CronStr interval has
+// elapsed. It blocks until ctx is cancelled.
+func (d *JobDispatcher) scheduleLoop(ctx context.Context) {
+	ticker := time.NewTicker(DefaultJobScheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.triggerScheduled()
+		}
+	}
+}
+
+// triggerScheduled enqueues a fresh run for every job whose CronStr
+// interval has elapsed since it last triggered, then advances its
+// UpdateTime so it isn't re-triggered again until the interval passes once
+// more.
+func (d *JobDispatcher) triggerScheduled() {
+	scheduled, err := d.jobRepo.FindScheduled()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "job dispatcher: list scheduled jobs: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	for _, template := range scheduled {
+		if !template.IsDue(now) {
+			continue
+		}
+
+		run, err := entities.NewJob(template.Type, template.Options, "", template.TriggeredBy)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "job dispatcher: create scheduled run for %s: %v\n", template.ID, err)
+			continue
+		}
+		if err := d.jobRepo.Save(run); err != nil {
+			fmt.Fprintf(os.Stderr, "job dispatcher: save scheduled run for %s: %v\n", template.ID, err)
+			continue
+		}
+		d.Enqueue(run)
+
+		template.UpdateTime = now
+		if err := d.jobRepo.Update(template); err != nil {
+			fmt.Fprintf(os.Stderr, "job dispatcher: update schedule for %s: %v\n", template.ID, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// DefaultPurgeWorkerInterval is how often the TenantPurgeWorker scans for
+// tenants past their PurgeAfter grace period.
+const DefaultPurgeWorkerInterval = 1 * time.Hour
+
+// TenantPurgeWorker periodically scans for soft-deleted tenants whose
+// grace period has elapsed and cascades their removal, including the
+// tenant's users and an audit record of the purge.
+type TenantPurgeWorker struct {
+	tenantRepo repositories.TenantRepository
+	userRepo   repositories.UserRepository
+	auditRepo  repositories.AuditRepository
+	interval   time.Duration
+}
+
+// NewTenantPurgeWorker creates a new TenantPurgeWorker that scans every
+// interval. A zero interval falls back to DefaultPurgeWorkerInterval.
+func NewTenantPurgeWorker(
+	tenantRepo repositories.TenantRepository,
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	interval time.Duration,
+) *TenantPurgeWorker {
+	if interval <= 0 {
+		interval = DefaultPurgeWorkerInterval
+	}
+	return &TenantPurgeWorker{
+		tenantRepo: tenantRepo,
+		userRepo:   userRepo,
+		auditRepo:  auditRepo,
+		interval:   interval,
+	}
+}
+
+// Run blocks, scanning on each tick until ctx is cancelled.
+func (w *TenantPurgeWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.scan()
+		}
+	}
+}
+
+// scan purges every deleted tenant whose grace period has elapsed.
+func (w *TenantPurgeWorker) scan() {
+	deleted, err := w.tenantRepo.FindDeleted()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tenant purge worker: list deleted tenants: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	for _, tenant := range deleted {
+		if !tenant.IsPurgeable(now) {
+			continue
+		}
+		if err := w.purge(tenant); err != nil {
+			fmt.Fprintf(os.Stderr, "tenant purge worker: purge %s: %v\n", tenant.ID, err)
+		}
+	}
+}
+
+// purge cascades the removal of a single tenant's child resources before
+// purging the tenant record itself.
+func (w *TenantPurgeWorker) purge(tenant *entities.Tenant) error {
+	users, err := w.userRepo.FindByTenant(tenant.ID)
+	if err != nil {
+		return fmt.Errorf("list tenant users: %w", err)
+	}
+	for _, user := range users {
+		if err := w.userRepo.Delete(user.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "tenant purge worker: delete user %s: %v\n", user.ID, err)
+		}
+	}
+
+	if err := w.tenantRepo.Purge(tenant.ID); err != nil {
+		return fmt.Errorf("purge tenant: %w", err)
+	}
+
+	auditEvent, err := entities.NewAuditEvent("tenant.purged", "delete", "WORKER", "/tenants/"+tenant.ID)
+	if err == nil {
+		auditEvent.WithResource("tenant", tenant.ID).WithTenant(tenant.ID).
+			AddMetadata("purged_users", len(users))
+		_ = w.auditRepo.Log(auditEvent)
+	}
+
+	return nil
+}
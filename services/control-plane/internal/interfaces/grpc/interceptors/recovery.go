@@ -0,0 +1,44 @@
+// Package interceptors provides gRPC unary/stream interceptors shared by
+// the control plane's gRPC gateway (internal/interfaces/grpc), mirroring
+// the Gin middleware under internal/interfaces/http/middleware for the
+// HTTP API.
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recovery recovers from a panic in the handler chain, logs the stack
+// trace, and converts it into a codes.Internal error rather than letting
+// it crash the process or leak the panic value to the caller.
+func Recovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				fmt.Fprintf(os.Stderr, "panic recovered [%s]: %v\n%s\n", info.FullMethod, rec, debug.Stack())
+				err = status.Errorf(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery is Recovery for streaming RPCs.
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				fmt.Fprintf(os.Stderr, "panic recovered [%s]: %v\n%s\n", info.FullMethod, rec, debug.Stack())
+				err = status.Errorf(codes.Internal, "internal error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,70 @@
+package interceptors
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataCarrier adapts incoming gRPC metadata to OpenTelemetry's
+// TextMapCarrier, so a trace started by an upstream HTTP or gRPC caller
+// continues here instead of starting a new one, the gRPC-side equivalent
+// of tracing.go's ginCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) { metadata.MD(c).Set(key, value) }
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Tracing starts a server-kind span for every unary RPC, extracting the
+// caller's trace context from incoming metadata, the gRPC-side
+// equivalent of TracingMiddleware.
+func Tracing(tracerName string) grpc.UnaryServerInterceptor {
+	tracer := otel.Tracer(tracerName)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+		ctx, span := tracer.Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("rpc.method", info.FullMethod)),
+		)
+		defer span.End()
+
+		if rc := RequestContextFrom(ctx); rc != nil {
+			span.SetAttributes(
+				attribute.String("user.id", rc.Subject),
+				attribute.String("tenant.id", rc.TenantID),
+			)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			span.RecordError(err)
+			if s, ok := status.FromError(err); ok {
+				span.SetAttributes(attribute.String("rpc.grpc.status_code", s.Code().String()))
+			}
+		}
+		return resp, err
+	}
+}
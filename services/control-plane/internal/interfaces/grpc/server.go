@@ -0,0 +1,42 @@
+// Package grpc wires up the control plane's gRPC gateway: a grpc.Server
+// carrying the same panic-recovery, tracing, and bearer-token
+// authentication guarantees as the Gin HTTP API (see
+// internal/interfaces/http). It builds no grpc-gateway reverse-proxy
+// mux, so the RPCs cannot be called over plain HTTP/JSON.
+//
+// Service implementations are registered against NewServer's returned
+// *grpc.Server via the generated RegisterXxxServer functions once
+// protoc/buf compiles proto/controlplane/v1/controlplane.proto; that
+// codegen step isn't run as part of this package.
+package grpc
+
+import (
+	"github.com/allsource/control-plane/internal/infrastructure/auth"
+	"github.com/allsource/control-plane/internal/interfaces/grpc/interceptors"
+	"google.golang.org/grpc"
+)
+
+// TracerName identifies spans this gateway's Tracing interceptor starts.
+const TracerName = "allsource-control-plane-grpc"
+
+// NewServer creates a grpc.Server with the standard interceptor chain:
+// Recovery (outermost, so it also catches panics in Auth/Tracing),
+// then Auth, then Tracing. Auth runs before Tracing so tracing can
+// attach the authenticated principal to the span it starts. verifier is
+// the same auth.Verifier used by httpmiddleware.Authenticate for the
+// HTTP API, so a token is accepted or rejected identically regardless
+// of which API surface it came in on. Streaming RPCs get Recovery and
+// Auth only; they are not traced.
+func NewServer(verifier *auth.Verifier) *grpc.Server {
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.Recovery(),
+			interceptors.Auth(verifier),
+			interceptors.Tracing(TracerName),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.StreamRecovery(),
+			interceptors.StreamAuth(verifier),
+		),
+	)
+}
@@ -0,0 +1,47 @@
+// Package httpx maps domain-layer errors to HTTP responses, so handlers
+// don't each re-implement the same err == domain.ErrXNotFound chain.
+package httpx
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// statusFor maps a domain.Code to the HTTP status it should surface as.
+func statusFor(code domain.Code) int {
+	switch code {
+	case domain.CodeNotFound:
+		return http.StatusNotFound
+	case domain.CodeAlreadyExists, domain.CodeConflict:
+		return http.StatusConflict
+	case domain.CodeNoPermission:
+		return http.StatusForbidden
+	case domain.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case domain.CodeValidationFailed:
+		return http.StatusBadRequest
+	case domain.CodeUnimplemented:
+		return http.StatusNotImplemented
+	case domain.CodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// WriteError writes err as a JSON error response, mapping its domain.Code
+// to the matching HTTP status. Errors that aren't a *domain.Error (e.g. a
+// bare error from c.ShouldBindJSON) are treated as validation failures,
+// since that's virtually always why a plain error reaches a handler.
+func WriteError(c *gin.Context, err error) {
+	code := domain.CodeValidationFailed
+	var de *domain.Error
+	if errors.As(err, &de) {
+		code = de.Code
+	}
+
+	c.JSON(statusFor(code), gin.H{"error": err.Error()})
+}
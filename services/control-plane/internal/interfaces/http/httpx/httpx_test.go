@@ -0,0 +1,44 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"NotFound", domain.ErrTenantNotFound, http.StatusNotFound},
+		{"AlreadyExists", domain.ErrPolicyAlreadyExists, http.StatusConflict},
+		{"NoPermission", domain.ErrForbidden, http.StatusForbidden},
+		{"Unauthenticated", domain.ErrUnauthorized, http.StatusUnauthorized},
+		{"ValidationFailed", domain.ErrInvalidInput, http.StatusBadRequest},
+		{"Internal", domain.ErrInternal, http.StatusInternalServerError},
+		{"Plain error defaults to validation failure", errors.New("bad request body"), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+
+			WriteError(c, tt.err)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %v, want %v", rec.Code, tt.want)
+			}
+		})
+	}
+}
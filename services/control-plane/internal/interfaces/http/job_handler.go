@@ -0,0 +1,97 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/application/usecases"
+	"github.com/allsource/control-plane/internal/interfaces/http/httpx"
+	"github.com/gin-gonic/gin"
+)
+
+// JobHandler handles asynchronous job HTTP requests
+type JobHandler struct {
+	createJobUC         *usecases.CreateJobUseCase
+	getJobUC            *usecases.GetJobUseCase
+	listJobsUC          *usecases.ListJobsUseCase
+	cancelJobUC         *usecases.CancelJobUseCase
+	listJobExecutionsUC *usecases.ListJobExecutionsUseCase
+}
+
+// NewJobHandler creates a new JobHandler
+func NewJobHandler(
+	createJobUC *usecases.CreateJobUseCase,
+	getJobUC *usecases.GetJobUseCase,
+	listJobsUC *usecases.ListJobsUseCase,
+	cancelJobUC *usecases.CancelJobUseCase,
+	listJobExecutionsUC *usecases.ListJobExecutionsUseCase,
+) *JobHandler {
+	return &JobHandler{
+		createJobUC:         createJobUC,
+		getJobUC:            getJobUC,
+		listJobsUC:          listJobsUC,
+		cancelJobUC:         cancelJobUC,
+		listJobExecutionsUC: listJobExecutionsUC,
+	}
+}
+
+// Create handles POST /api/v1/jobs
+func (h *JobHandler) Create(c *gin.Context) {
+	var req dto.CreateJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.createJobUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// List handles GET /api/v1/jobs
+func (h *JobHandler) List(c *gin.Context) {
+	resp, err := h.listJobsUC.Execute()
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"jobs": resp})
+}
+
+// Get handles GET /api/v1/jobs/:id
+func (h *JobHandler) Get(c *gin.Context) {
+	resp, err := h.getJobUC.Execute(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Cancel handles POST /api/v1/jobs/:id/cancel
+func (h *JobHandler) Cancel(c *gin.Context) {
+	resp, err := h.cancelJobUC.Execute(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Executions handles GET /api/v1/jobs/:id/executions
+func (h *JobHandler) Executions(c *gin.Context) {
+	resp, err := h.listJobExecutionsUC.Execute(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"executions": resp})
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// maxLoggedBodyBytes caps how much of a request/response body AccessLog
+// retains, so a large payload doesn't blow out the log.
+const maxLoggedBodyBytes = 2 * 1024
+
+// sensitiveBodyFields are redacted where they appear as a top-level key of
+// a JSON object body. Keys in nested objects are not redacted, and a body
+// cut short at maxLoggedBodyBytes no longer parses as JSON and is logged
+// without redaction.
+var sensitiveBodyFields = map[string]bool{
+	"password": true,
+	"token":    true,
+	"secret":   true,
+	"api_key":  true,
+}
+
+// bodyCaptureWriter tees every Write into a capped buffer while still
+// forwarding the full write to the real gin.ResponseWriter.
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(data []byte) (int, error) {
+	if remaining := maxLoggedBodyBytes - w.body.Len(); remaining > 0 {
+		if len(data) <= remaining {
+			w.body.Write(data)
+		} else {
+			w.body.Write(data[:remaining])
+		}
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// AccessLog logs each request's method, path, status, duration and
+// request ID, along with sanitized request/response bodies, through
+// logger. It must run before any middleware that consumes the request
+// body, since it restores the body after reading it.
+func AccessLog(logger *logging.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reqBody := readAndRestoreBody(c)
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		start := time.Now()
+		c.Next()
+		duration := time.Since(start)
+
+		logger.Info("%s %s %d %s request_id=%s body=%s response=%s",
+			c.Request.Method, c.Request.URL.Path, c.Writer.Status(), duration,
+			RequestIDFrom(c), redactBody(reqBody), redactBody(writer.body.String()))
+	}
+}
+
+func readAndRestoreBody(c *gin.Context) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return ""
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(data))
+
+	if len(data) > maxLoggedBodyBytes {
+		data = data[:maxLoggedBodyBytes]
+	}
+	return string(data)
+}
+
+// redactBody replaces sensitiveBodyFields values in a JSON object body
+// with "[REDACTED]". Bodies that aren't a JSON object are returned as-is,
+// since redaction can only reason about structure it can parse.
+func redactBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &parsed); err != nil {
+		return body
+	}
+
+	for key := range parsed {
+		if sensitiveBodyFields[key] {
+			parsed[key] = "[REDACTED]"
+		}
+	}
+
+	redacted, err := json.Marshal(parsed)
+	if err != nil {
+		return body
+	}
+	return string(redacted)
+}
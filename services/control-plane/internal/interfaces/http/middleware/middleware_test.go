@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// testLogger returns a Logger that discards everything below LevelFatal,
+// so tests exercising Recovery/AccessLog don't spam stdout.
+func testLogger() *logging.Logger {
+	registry, _ := logging.NewRegistry(logging.Config{Default: "fatal"})
+	return registry.Get("test")
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "Redacts a sensitive field",
+			body: `{"username":"alice","password":"hunter2"}`,
+			want: `{"password":"[REDACTED]","username":"alice"}`,
+		},
+		{
+			name: "Non-JSON body left as-is",
+			body: "not json",
+			want: "not json",
+		},
+		{
+			name: "Empty body left as-is",
+			body: "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody(tt.body); got != tt.want {
+				t.Errorf("redactBody() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestID_GeneratesWhenAbsent(t *testing.T) {
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/", func(c *gin.Context) {
+		if RequestIDFrom(c) == "" {
+			t.Error("RequestIDFrom should return a non-empty ID inside the handler")
+		}
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Header().Get(RequestIDHeader) == "" {
+		t.Error("response should echo a generated request ID")
+	}
+}
+
+func TestRequestID_ReusesInboundHeader(t *testing.T) {
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "fixed-id")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(RequestIDHeader); got != "fixed-id" {
+		t.Errorf("request ID = %v, want fixed-id", got)
+	}
+}
+
+func TestRecovery_ConvertsPanicTo500(t *testing.T) {
+	router := gin.New()
+	router.Use(RequestID())
+	router.Use(Recovery(testLogger()))
+	router.GET("/", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestRequestTimeout_AbortsSlowHandlers(t *testing.T) {
+	router := gin.New()
+	router.Use(RequestID())
+	router.Use(RequestTimeout(10 * time.Millisecond))
+	router.GET("/", func(c *gin.Context) {
+		time.Sleep(50 * time.Millisecond)
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusServiceUnavailable)
+	}
+}
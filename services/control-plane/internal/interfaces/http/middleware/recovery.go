@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"runtime/debug"
+
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery recovers from panics in downstream handlers, logs the stack
+// trace through logger, and responds with domain.ErrInternal and the
+// request's ID rather than letting the panic terminate the goroutine or
+// leak implementation details to the caller. Register it after RequestID
+// so the logged/returned ID is already set.
+func Recovery(logger *logging.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			requestID := RequestIDFrom(c)
+			route := c.FullPath()
+			if route == "" {
+				route = c.Request.URL.Path
+			}
+
+			logger.Error("panic recovered [%s] %s %s: %v\n%s",
+				requestID, c.Request.Method, route, rec, debug.Stack())
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error":      domain.ErrInternal.Error(),
+				"request_id": requestID,
+			})
+		}()
+
+		c.Next()
+	}
+}
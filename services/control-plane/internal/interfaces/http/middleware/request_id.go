@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// requestIDContextKey is the gin-context key RequestID stores the
+// request's ID under.
+const requestIDContextKey = "request_id"
+
+// RequestIDHeader is the HTTP header carrying the request ID, both
+// inbound (caller-supplied) and outbound (echoed on the response).
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID propagates a request ID through the request lifecycle: it
+// reuses the inbound X-Request-ID header if present, otherwise generates
+// one, stores it in the gin context for downstream middleware and
+// handlers, and echoes it on the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		}
+
+		c.Set(requestIDContextKey, id)
+		c.Writer.Header().Set(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// RequestIDFrom returns the request ID RequestID stored on c, or "" if
+// RequestID wasn't registered ahead of the caller.
+func RequestIDFrom(c *gin.Context) string {
+	id, _ := c.Get(requestIDContextKey)
+	s, _ := id.(string)
+	return s
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestTimeout aborts a request with 503 if it hasn't finished within d.
+// The handler keeps running in its own goroutine to completion (Go has no
+// way to forcibly cancel one), but its eventual write to c.Writer is
+// ignored once the deadline response has already been sent.
+func RequestTimeout(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		done := make(chan struct{})
+		go func() {
+			c.Next()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":      "request timed out",
+				"request_id": RequestIDFrom(c),
+			})
+		}
+	}
+}
@@ -5,18 +5,54 @@ import (
 
 	"github.com/allsource/control-plane/internal/application/dto"
 	"github.com/allsource/control-plane/internal/application/usecases"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/interfaces/http/httpx"
+	"github.com/allsource/control-plane/internal/interfaces/http/middleware"
 	"github.com/gin-gonic/gin"
 )
 
 // PolicyHandler handles policy-related HTTP requests
 type PolicyHandler struct {
-	evaluatePolicyUC *usecases.EvaluatePolicyUseCase
+	evaluatePolicyUC    *usecases.EvaluatePolicyUseCase
+	simulatePolicyUC    *usecases.SimulatePolicyUseCase
+	createPolicyUC      *usecases.CreatePolicyUseCase
+	updatePolicyUC      *usecases.UpdatePolicyUseCase
+	deletePolicyUC      *usecases.DeletePolicyUseCase
+	listPoliciesUC      *usecases.ListPoliciesUseCase
+	getPolicyUC         *usecases.GetPolicyUseCase
+	setPolicyEnabledUC  *usecases.SetPolicyEnabledUseCase
+	getPolicyBindingsUC *usecases.GetPolicyBindingsUseCase
+	unbindPolicyUC      *usecases.UnbindPolicyUseCase
+	testPolicyUC        *usecases.TestPolicyUseCase
 }
 
 // NewPolicyHandler creates a new PolicyHandler
-func NewPolicyHandler(evaluatePolicyUC *usecases.EvaluatePolicyUseCase) *PolicyHandler {
+func NewPolicyHandler(
+	evaluatePolicyUC *usecases.EvaluatePolicyUseCase,
+	simulatePolicyUC *usecases.SimulatePolicyUseCase,
+	createPolicyUC *usecases.CreatePolicyUseCase,
+	updatePolicyUC *usecases.UpdatePolicyUseCase,
+	deletePolicyUC *usecases.DeletePolicyUseCase,
+	listPoliciesUC *usecases.ListPoliciesUseCase,
+	getPolicyUC *usecases.GetPolicyUseCase,
+	setPolicyEnabledUC *usecases.SetPolicyEnabledUseCase,
+	getPolicyBindingsUC *usecases.GetPolicyBindingsUseCase,
+	unbindPolicyUC *usecases.UnbindPolicyUseCase,
+	testPolicyUC *usecases.TestPolicyUseCase,
+) *PolicyHandler {
 	return &PolicyHandler{
-		evaluatePolicyUC: evaluatePolicyUC,
+		evaluatePolicyUC:    evaluatePolicyUC,
+		simulatePolicyUC:    simulatePolicyUC,
+		createPolicyUC:      createPolicyUC,
+		updatePolicyUC:      updatePolicyUC,
+		deletePolicyUC:      deletePolicyUC,
+		listPoliciesUC:      listPoliciesUC,
+		getPolicyUC:         getPolicyUC,
+		setPolicyEnabledUC:  setPolicyEnabledUC,
+		getPolicyBindingsUC: getPolicyBindingsUC,
+		unbindPolicyUC:      unbindPolicyUC,
+		testPolicyUC:        testPolicyUC,
 	}
 }
 
@@ -24,13 +60,200 @@ func NewPolicyHandler(evaluatePolicyUC *usecases.EvaluatePolicyUseCase) *PolicyH
 func (h *PolicyHandler) Evaluate(c *gin.Context) {
 	var req dto.EvaluatePolicyRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httpx.WriteError(c, err)
+		return
+	}
+
+	if err := enforceTenantScope(c, req.Attributes); err != nil {
+		httpx.WriteError(c, err)
 		return
 	}
 
 	resp, err := h.evaluatePolicyUC.Execute(req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// enforceTenantScope rejects a request whose attributes claim a
+// "tenant_id" other than the authenticated principal's, unless the
+// principal holds the Admin role, so a caller can't spoof tenant_id in
+// the request body to evaluate policies scoped to a tenant it doesn't
+// belong to. attributes["tenant_id"] is filled in from the principal when
+// unset. It's a no-op when no Authenticate middleware ran ahead of this
+// handler (RequestContextFrom returns nil), so deployments without auth
+// wired in keep today's behavior.
+func enforceTenantScope(c *gin.Context, attributes map[string]interface{}) error {
+	rc := middleware.RequestContextFrom(c)
+	if rc == nil {
+		return nil
+	}
+	if rc.HasRole(string(entities.RoleAdmin)) {
+		return nil
+	}
+
+	if requested, _ := attributes["tenant_id"].(string); requested != "" && requested != rc.TenantID {
+		return domain.ErrForbidden
+	}
+	attributes["tenant_id"] = rc.TenantID
+	return nil
+}
+
+// Simulate handles POST /api/v1/policies/simulate
+func (h *PolicyHandler) Simulate(c *gin.Context) {
+	var req dto.SimulatePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.simulatePolicyUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DryRun handles POST /api/v1/policies/dry-run. It is an alias for Simulate
+// scoped to the /policies collection: given a synthetic resource and
+// attribute map, it returns the full decision trace (every policy
+// considered, not just the one that matched) without mutating anything.
+func (h *PolicyHandler) DryRun(c *gin.Context) {
+	h.Simulate(c)
+}
+
+// List handles GET /api/v1/policies
+func (h *PolicyHandler) List(c *gin.Context) {
+	resp, err := h.listPoliciesUC.Execute()
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Get handles GET /api/v1/policies/:id
+func (h *PolicyHandler) Get(c *gin.Context) {
+	resp, err := h.getPolicyUC.Execute(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Create handles POST /api/v1/policies
+func (h *PolicyHandler) Create(c *gin.Context) {
+	var req dto.CreatePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.createPolicyUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Update handles PUT /api/v1/policies/:id
+func (h *PolicyHandler) Update(c *gin.Context) {
+	var req dto.UpdatePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.updatePolicyUC.Execute(c.Param("id"), req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Delete handles DELETE /api/v1/policies/:id
+func (h *PolicyHandler) Delete(c *gin.Context) {
+	if err := h.deletePolicyUC.Execute(c.Param("id")); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Enable handles POST /api/v1/policies/:id/enable
+func (h *PolicyHandler) Enable(c *gin.Context) {
+	h.setEnabled(c, true)
+}
+
+// Disable handles POST /api/v1/policies/:id/disable
+func (h *PolicyHandler) Disable(c *gin.Context) {
+	h.setEnabled(c, false)
+}
+
+func (h *PolicyHandler) setEnabled(c *gin.Context, enabled bool) {
+	resp, err := h.setPolicyEnabledUC.Execute(c.Param("id"), enabled)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Bindings handles GET /api/v1/policies/:id/bindings
+func (h *PolicyHandler) Bindings(c *gin.Context) {
+	resp, err := h.getPolicyBindingsUC.Execute(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Unbind handles POST /api/v1/policies/:id/unbind
+func (h *PolicyHandler) Unbind(c *gin.Context) {
+	var req dto.UnbindPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.unbindPolicyUC.Execute(c.Param("id"), req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Test handles POST /api/v1/policies/test: it dry-runs a policy
+// definition from the request body (builtin or rego) against sample
+// input, without ever persisting it.
+func (h *PolicyHandler) Test(c *gin.Context) {
+	var req dto.TestPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.testPolicyUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
 		return
 	}
 
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/application/usecases"
+	"github.com/allsource/control-plane/internal/interfaces/http/httpx"
+	"github.com/gin-gonic/gin"
+)
+
+// ReplicationHandler handles replication target/policy/execution HTTP
+// requests. Receiving a peer's pushed bundle needs the signature-verifying
+// Receiver, not just these use cases, so that endpoint is handled
+// separately by ControlPlane rather than here, mirroring
+// SnapshotHandler/snapshotDownloadHandler's split.
+type ReplicationHandler struct {
+	createTargetUC   *usecases.CreateReplicationTargetUseCase
+	listTargetsUC    *usecases.ListReplicationTargetsUseCase
+	createPolicyUC   *usecases.CreateReplicationPolicyUseCase
+	listPoliciesUC   *usecases.ListReplicationPoliciesUseCase
+	triggerUC        *usecases.TriggerReplicationUseCase
+	listExecutionsUC *usecases.ListReplicationExecutionsUseCase
+}
+
+// NewReplicationHandler creates a new ReplicationHandler.
+func NewReplicationHandler(
+	createTargetUC *usecases.CreateReplicationTargetUseCase,
+	listTargetsUC *usecases.ListReplicationTargetsUseCase,
+	createPolicyUC *usecases.CreateReplicationPolicyUseCase,
+	listPoliciesUC *usecases.ListReplicationPoliciesUseCase,
+	triggerUC *usecases.TriggerReplicationUseCase,
+	listExecutionsUC *usecases.ListReplicationExecutionsUseCase,
+) *ReplicationHandler {
+	return &ReplicationHandler{
+		createTargetUC:   createTargetUC,
+		listTargetsUC:    listTargetsUC,
+		createPolicyUC:   createPolicyUC,
+		listPoliciesUC:   listPoliciesUC,
+		triggerUC:        triggerUC,
+		listExecutionsUC: listExecutionsUC,
+	}
+}
+
+// CreateTarget handles POST /api/v1/replication/targets
+func (h *ReplicationHandler) CreateTarget(c *gin.Context) {
+	var req dto.CreateReplicationTargetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.createTargetUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListTargets handles GET /api/v1/replication/targets
+func (h *ReplicationHandler) ListTargets(c *gin.Context) {
+	resp, err := h.listTargetsUC.Execute()
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"targets": resp})
+}
+
+// CreatePolicy handles POST /api/v1/replication/policies
+func (h *ReplicationHandler) CreatePolicy(c *gin.Context) {
+	var req dto.CreateReplicationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.createPolicyUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListPolicies handles GET /api/v1/replication/policies
+func (h *ReplicationHandler) ListPolicies(c *gin.Context) {
+	resp, err := h.listPoliciesUC.Execute()
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"policies": resp})
+}
+
+// Trigger handles POST /api/v1/replication/policies/:id/trigger
+func (h *ReplicationHandler) Trigger(c *gin.Context) {
+	resp, err := h.triggerUC.Execute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListExecutions handles GET /api/v1/replication/executions
+func (h *ReplicationHandler) ListExecutions(c *gin.Context) {
+	resp, err := h.listExecutionsUC.Execute()
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"executions": resp})
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/application/usecases"
+	"github.com/allsource/control-plane/internal/interfaces/http/httpx"
+	"github.com/gin-gonic/gin"
+)
+
+// SnapshotHandler handles snapshot-manifest HTTP requests. Downloading the
+// underlying artifact needs the SnapshotStore, not just the manifest
+// repository these use cases read from, so that endpoint is handled
+// separately by ControlPlane rather than here.
+type SnapshotHandler struct {
+	listSnapshotsUC *usecases.ListSnapshotsUseCase
+	getSnapshotUC   *usecases.GetSnapshotUseCase
+}
+
+// NewSnapshotHandler creates a new SnapshotHandler
+func NewSnapshotHandler(listSnapshotsUC *usecases.ListSnapshotsUseCase, getSnapshotUC *usecases.GetSnapshotUseCase) *SnapshotHandler {
+	return &SnapshotHandler{
+		listSnapshotsUC: listSnapshotsUC,
+		getSnapshotUC:   getSnapshotUC,
+	}
+}
+
+// List handles GET /api/v1/operations/snapshots
+func (h *SnapshotHandler) List(c *gin.Context) {
+	var req dto.ListSnapshotsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	resp, err := h.listSnapshotsUC.Execute(c.Request.Context(), req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Get handles GET /api/v1/operations/snapshots/:id
+func (h *SnapshotHandler) Get(c *gin.Context) {
+	resp, err := h.getSnapshotUC.Execute(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
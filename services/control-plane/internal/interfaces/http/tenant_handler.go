@@ -5,19 +5,23 @@ import (
 
 	"github.com/allsource/control-plane/internal/application/dto"
 	"github.com/allsource/control-plane/internal/application/usecases"
-	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/interfaces/http/httpx"
+	"github.com/allsource/control-plane/internal/interfaces/http/middleware"
 	"github.com/gin-gonic/gin"
 )
 
 // TenantHandler handles tenant-related HTTP requests
 type TenantHandler struct {
-	createTenantUC *usecases.CreateTenantUseCase
+	createTenantUC  *usecases.CreateTenantUseCase
+	restoreTenantUC *usecases.RestoreTenantUseCase
 }
 
 // NewTenantHandler creates a new TenantHandler
-func NewTenantHandler(createTenantUC *usecases.CreateTenantUseCase) *TenantHandler {
+func NewTenantHandler(createTenantUC *usecases.CreateTenantUseCase, restoreTenantUC *usecases.RestoreTenantUseCase) *TenantHandler {
 	return &TenantHandler{
-		createTenantUC: createTenantUC,
+		createTenantUC:  createTenantUC,
+		restoreTenantUC: restoreTenantUC,
 	}
 }
 
@@ -25,19 +29,33 @@ func NewTenantHandler(createTenantUC *usecases.CreateTenantUseCase) *TenantHandl
 func (h *TenantHandler) Create(c *gin.Context) {
 	var req dto.CreateTenantRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httpx.WriteError(c, err)
 		return
 	}
 
 	resp, err := h.createTenantUC.Execute(req)
 	if err != nil {
-		if err == domain.ErrTenantAlreadyExists {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
-			return
-		}
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httpx.WriteError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, resp)
 }
+
+// Restore handles POST /api/v1/tenants/:id/restore
+func (h *TenantHandler) Restore(c *gin.Context) {
+	req := dto.RestoreTenantRequest{ID: c.Param("id")}
+
+	if rc := middleware.RequestContextFrom(c); rc != nil {
+		req.RequestedByTenantID = rc.TenantID
+		req.RequestedByIsAdmin = rc.HasRole(string(entities.RoleAdmin))
+	}
+
+	resp, err := h.restoreTenantUC.Execute(req)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
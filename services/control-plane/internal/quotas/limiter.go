@@ -0,0 +1,288 @@
+// Package quotas implements per-tenant rate limiting and concurrency caps
+// enforced at the control plane edge. A token-bucket Limiter bounds how
+// many read/write/operations requests a tenant can make per second, and a
+// separate in-flight counter bounds concurrent privileged operations,
+// since a handful of slow requests can overwhelm core while staying well
+// under any request-rate limit.
+package quotas
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RouteGroup classifies a request for quota purposes.
+type RouteGroup string
+
+const (
+	GroupRead       RouteGroup = "read"
+	GroupWrite      RouteGroup = "write"
+	GroupOperations RouteGroup = "operations"
+)
+
+// ClassifyRoute maps an HTTP method and path to a RouteGroup: anything
+// under /api/v1/operations is GroupOperations regardless of method, a
+// GET/HEAD elsewhere is GroupRead, and everything else is GroupWrite.
+func ClassifyRoute(method, path string) RouteGroup {
+	if strings.HasPrefix(path, "/api/v1/operations") {
+		return GroupOperations
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return GroupRead
+	default:
+		return GroupWrite
+	}
+}
+
+// Limit is one route group's token-bucket configuration.
+type Limit struct {
+	Capacity        int     `yaml:"capacity" json:"capacity"`
+	RefillPerSecond float64 `yaml:"refill_per_second" json:"refill_per_second"`
+}
+
+// TenantQuotas is one tenant's quota overrides. A RouteGroup missing from
+// Limits falls back to the Limiter's default for that group, and a zero
+// OperationsConcurrency falls back to the Limiter's default concurrency
+// cap.
+type TenantQuotas struct {
+	Limits                map[RouteGroup]Limit
+	OperationsConcurrency int
+}
+
+// DefaultLimits is the fallback token-bucket configuration for any
+// (tenant, group) pair without a tenant-specific override.
+func DefaultLimits() map[RouteGroup]Limit {
+	return map[RouteGroup]Limit{
+		GroupRead:       {Capacity: 300, RefillPerSecond: 50},
+		GroupWrite:      {Capacity: 60, RefillPerSecond: 10},
+		GroupOperations: {Capacity: 5, RefillPerSecond: 1},
+	}
+}
+
+// DefaultOperationsConcurrency is the in-flight operations cap used for
+// any tenant without an override.
+const DefaultOperationsConcurrency = 2
+
+// bucket is one (tenant, group) token bucket.
+type bucket struct {
+	tokens   float64
+	limit    Limit
+	lastFill time.Time
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastFill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += elapsed * b.limit.RefillPerSecond
+	if b.tokens > float64(b.limit.Capacity) {
+		b.tokens = float64(b.limit.Capacity)
+	}
+	b.lastFill = now
+}
+
+// Recorder lets Limiter report quota outcomes without depending on a
+// specific metrics library. ControlPlaneMetrics implements it via
+// RecordQuotaRejected/RecordQuotaTokens.
+type Recorder interface {
+	RecordQuotaRejected(tenantID string, group RouteGroup)
+	RecordQuotaTokens(tenantID string, group RouteGroup, tokens float64)
+}
+
+// noopRecorder discards every call, used when Limiter is built without a
+// Recorder.
+type noopRecorder struct{}
+
+func (noopRecorder) RecordQuotaRejected(string, RouteGroup)        {}
+func (noopRecorder) RecordQuotaTokens(string, RouteGroup, float64) {}
+
+// Result is the outcome of an Allow check.
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Limiter enforces a token-bucket rate limit and an operations concurrency
+// cap per tenant.
+type Limiter struct {
+	mu       sync.Mutex
+	defaults map[RouteGroup]Limit
+	tenants  map[string]TenantQuotas
+	buckets  map[string]*bucket
+	inFlight map[string]int
+	recorder Recorder
+
+	defaultOperationsConcurrency int
+}
+
+// NewLimiter creates a Limiter. defaults supplies the fallback Limit for
+// any (tenant, group) without a per-tenant override; recorder may be nil,
+// in which case quota outcomes aren't recorded.
+func NewLimiter(defaults map[RouteGroup]Limit, recorder Recorder) *Limiter {
+	if recorder == nil {
+		recorder = noopRecorder{}
+	}
+	return &Limiter{
+		defaults:                     defaults,
+		tenants:                      make(map[string]TenantQuotas),
+		buckets:                      make(map[string]*bucket),
+		inFlight:                     make(map[string]int),
+		recorder:                     recorder,
+		defaultOperationsConcurrency: DefaultOperationsConcurrency,
+	}
+}
+
+// SetTenantQuotas installs or replaces tenantID's quota overrides, e.g.
+// from the PUT /api/v1/tenants/:id/quotas endpoint or LoadConfigFile.
+func (l *Limiter) SetTenantQuotas(tenantID string, q TenantQuotas) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tenants[tenantID] = q
+	// Drop cached buckets so the new capacity/refill rate takes effect
+	// immediately instead of waiting for the old bucket to drain.
+	for _, group := range []RouteGroup{GroupRead, GroupWrite, GroupOperations} {
+		delete(l.buckets, bucketKey(tenantID, group))
+	}
+}
+
+// TenantQuotas returns tenantID's configured overrides, or false if it has
+// none and is subject only to the defaults.
+func (l *Limiter) TenantQuotas(tenantID string) (TenantQuotas, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	q, ok := l.tenants[tenantID]
+	return q, ok
+}
+
+func bucketKey(tenantID string, group RouteGroup) string {
+	return tenantID + "|" + string(group)
+}
+
+func (l *Limiter) limitFor(tenantID string, group RouteGroup) Limit {
+	if q, ok := l.tenants[tenantID]; ok {
+		if limit, ok := q.Limits[group]; ok {
+			return limit
+		}
+	}
+	return l.defaults[group]
+}
+
+func (l *Limiter) operationsConcurrencyFor(tenantID string) int {
+	if q, ok := l.tenants[tenantID]; ok && q.OperationsConcurrency > 0 {
+		return q.OperationsConcurrency
+	}
+	return l.defaultOperationsConcurrency
+}
+
+// Allow consumes one token from tenantID's group bucket at time now,
+// creating the bucket (full) on first use. A group with no configured
+// capacity (Capacity <= 0) is treated as unlimited.
+func (l *Limiter) Allow(tenantID string, group RouteGroup, now time.Time) Result {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := l.limitFor(tenantID, group)
+	if limit.Capacity <= 0 {
+		return Result{Allowed: true, Remaining: -1}
+	}
+
+	key := bucketKey(tenantID, group)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Capacity), limit: limit, lastFill: now}
+		l.buckets[key] = b
+	}
+	b.limit = limit
+	b.refill(now)
+	l.recorder.RecordQuotaTokens(tenantID, group, b.tokens)
+
+	if b.tokens < 1 {
+		l.recorder.RecordQuotaRejected(tenantID, group)
+		retryAfter := time.Second
+		if limit.RefillPerSecond > 0 {
+			retryAfter = time.Duration((1 - b.tokens) / limit.RefillPerSecond * float64(time.Second))
+		}
+		return Result{Allowed: false, Remaining: 0, RetryAfter: retryAfter}
+	}
+
+	b.tokens--
+	return Result{Allowed: true, Remaining: int(b.tokens)}
+}
+
+// AcquireOperationSlot reserves one of tenantID's in-flight operations
+// slots. On success, the caller must invoke release exactly once,
+// typically via defer, when the operation completes.
+func (l *Limiter) AcquireOperationSlot(tenantID string) (release func(), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	max := l.operationsConcurrencyFor(tenantID)
+	if l.inFlight[tenantID] >= max {
+		return nil, false
+	}
+	l.inFlight[tenantID]++
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.inFlight[tenantID]--
+	}, true
+}
+
+// quotaFileSchema is the YAML/JSON shape a quota config file parses into.
+type quotaFileSchema struct {
+	Tenants []quotaFileEntry `yaml:"tenants"`
+}
+
+// quotaFileEntry is one tenant's overrides in a quotaFileSchema. A nil
+// Read/Write/Operations leaves that group on the Limiter's default.
+type quotaFileEntry struct {
+	ID                    string `yaml:"id"`
+	Read                  *Limit `yaml:"read"`
+	Write                 *Limit `yaml:"write"`
+	Operations            *Limit `yaml:"operations"`
+	OperationsConcurrency int    `yaml:"operations_concurrency"`
+}
+
+func (e quotaFileEntry) toTenantQuotas() TenantQuotas {
+	limits := make(map[RouteGroup]Limit)
+	if e.Read != nil {
+		limits[GroupRead] = *e.Read
+	}
+	if e.Write != nil {
+		limits[GroupWrite] = *e.Write
+	}
+	if e.Operations != nil {
+		limits[GroupOperations] = *e.Operations
+	}
+	return TenantQuotas{Limits: limits, OperationsConcurrency: e.OperationsConcurrency}
+}
+
+// LoadConfigFile parses a quotas.yaml-style file (YAML is a superset of
+// JSON, so the same schema serves both) into the TenantQuotas overrides it
+// describes, keyed by tenant ID.
+func LoadConfigFile(path string) (map[string]TenantQuotas, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read quota config %q: %w", path, err)
+	}
+
+	var schema quotaFileSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parse quota config %q: %w", path, err)
+	}
+
+	quotas := make(map[string]TenantQuotas, len(schema.Tenants))
+	for _, entry := range schema.Tenants {
+		quotas[entry.ID] = entry.toTenantQuotas()
+	}
+	return quotas, nil
+}
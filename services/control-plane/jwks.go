@@ -0,0 +1,236 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultKeyRefresh is how long a JWKSKeyProvider caches fetched keys
+// before re-fetching, unless AuthConfig.RefreshInterval overrides it.
+const DefaultKeyRefresh = 15 * time.Minute
+
+// JWKSKeyProvider fetches and caches an OIDC provider's JSON Web Key
+// Set, resolving a token's kid header to the RSA or EC public key that
+// should verify it. A background goroutine re-fetches the set every
+// refresh interval so a newly rotated signing key is picked up before
+// any token using it arrives; a kid not found in the cache additionally
+// triggers an immediate synchronous re-fetch rather than waiting for the
+// next tick, since a provider can rotate ahead of schedule.
+type JWKSKeyProvider struct {
+	url     string
+	refresh time.Duration
+
+	mu      sync.RWMutex
+	keys    map[string]interface{}
+	fetched time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewJWKSKeyProvider creates a JWKSKeyProvider for url and starts its
+// background refresh goroutine, re-fetching at most once per refresh (or
+// DefaultKeyRefresh, if refresh is zero). Call Close to stop it.
+func NewJWKSKeyProvider(url string, refresh time.Duration) *JWKSKeyProvider {
+	if refresh <= 0 {
+		refresh = DefaultKeyRefresh
+	}
+	p := &JWKSKeyProvider{
+		url:     url,
+		refresh: refresh,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go p.refreshLoop()
+	return p
+}
+
+// refreshLoop proactively re-fetches the JWKS once per refresh interval
+// until Close is called.
+func (p *JWKSKeyProvider) refreshLoop() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.refresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.refetch()
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// refetch re-fetches the JWKS document, replacing the cached key set on
+// success. A failed fetch leaves the existing cache in place, so a
+// transient outage at the IdP doesn't invalidate keys that still work.
+func (p *JWKSKeyProvider) refetch() (map[string]interface{}, error) {
+	keys, err := fetchJWKS(p.url)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.keys = keys
+	p.fetched = time.Now()
+	p.mu.Unlock()
+	return keys, nil
+}
+
+// Close stops the background refresh goroutine, blocking until it has
+// exited. Safe to call once; not safe to call concurrently with itself.
+func (p *JWKSKeyProvider) Close() {
+	close(p.stop)
+	<-p.done
+}
+
+// Key implements KeyProvider, resolving token's kid header to an RSA or
+// EC public key.
+func (p *JWKSKeyProvider) Key(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, fmt.Errorf("token missing kid header")
+	}
+
+	p.mu.RLock()
+	key, ok := p.keys[kid]
+	fresh := ok && time.Since(p.fetched) < p.refresh
+	p.mu.RUnlock()
+	if fresh {
+		return key, nil
+	}
+
+	keys, err := p.refetch()
+	if err != nil {
+		p.mu.RLock()
+		cached, ok := p.keys[kid]
+		p.mu.RUnlock()
+		if ok {
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("no JWKS key found for kid %q", kid)
+	}
+	return key, nil
+}
+
+type jwksDocument struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func fetchJWKS(url string) (map[string]interface{}, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
+	var doc jwksDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(doc.Keys))
+	for _, k := range doc.Keys {
+		var (
+			key interface{}
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsaPublicKey()
+		case "EC":
+			key, err = k.ecPublicKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+// rsaPublicKey decodes the base64url-encoded modulus (n) and exponent
+// (e) of an RSA JWK into an *rsa.PublicKey.
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	eBuf := make([]byte, 8)
+	copy(eBuf[8-len(eBytes):], eBytes)
+	e := int(binary.BigEndian.Uint64(eBuf))
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: e,
+	}, nil
+}
+
+// ecPublicKey decodes the base64url-encoded coordinates (x, y) of an EC
+// JWK into an *ecdsa.PublicKey, for the curve named by crv.
+func (k jsonWebKey) ecPublicKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported EC curve %q", k.Crv)
+	}
+
+	xBytes, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x coordinate: %w", err)
+	}
+	yBytes, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y coordinate: %w", err)
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(xBytes),
+		Y:     new(big.Int).SetBytes(yBytes),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"time"
+
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// GinAccessLogMiddleware replaces gin's own console logger with one routed
+// through the shared logging pipeline, so request access logs land on the
+// same writers (console, file, syslog) as audit failures and policy
+// decisions.
+func GinAccessLogMiddleware(logger *logging.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		duration := time.Since(start)
+
+		logger.Info("%s %s %d %s", c.Request.Method, c.Request.URL.Path, c.Writer.Status(), duration)
+	}
+}
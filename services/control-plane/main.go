@@ -1,40 +1,144 @@
 package main
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/ed25519"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/allsource/control-plane/internal"
+	"github.com/allsource/control-plane/internal/application/dto"
+	"github.com/allsource/control-plane/internal/domain"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/infrastructure/auth"
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
+	"github.com/allsource/control-plane/internal/infrastructure/replication"
+	"github.com/allsource/control-plane/internal/infrastructure/snapshot"
+	"github.com/allsource/control-plane/internal/infrastructure/workers"
+	controlplanegrpc "github.com/allsource/control-plane/internal/interfaces/grpc"
+	"github.com/allsource/control-plane/internal/interfaces/http/httpx"
+	httpmiddleware "github.com/allsource/control-plane/internal/interfaces/http/middleware"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/gin-gonic/gin"
 	"github.com/go-resty/resty/v2"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 )
 
 const (
-	DefaultPort     = "8081"
-	CoreServiceURL  = "http://localhost:8080"
+	DefaultPort    = "8081"
+	CoreServiceURL = "http://localhost:8080"
+	// RequestTimeout bounds how long a single request may run before
+	// httpmiddleware.RequestTimeout aborts it with 503.
+	RequestTimeout = 30 * time.Second
+
+	// DefaultSnapshotLocalDir is where newSnapshotStore writes snapshot
+	// artifacts when SNAPSHOT_S3_BUCKET isn't set.
+	DefaultSnapshotLocalDir = "./data/snapshots"
+
+	// DefaultSnapshotDownloadURLExpiry is how long a presigned snapshot
+	// download URL remains valid.
+	DefaultSnapshotDownloadURLExpiry = 15 * time.Minute
 )
 
 type ControlPlane struct {
-	client    *resty.Client
-	router    *gin.Engine
-	metrics   *ControlPlaneMetrics
-	container *internal.Container
+	client       *resty.Client
+	router       *gin.Engine
+	metrics      *ControlPlaneMetrics
+	container    *internal.Container
+	policyEngine *PolicyEngine
+	auditLogger  *AuditLogger
+	logRegistry  *logging.Registry
+
+	// auditLogPath is the FileAuditSink path auditVerifyHandler walks.
+	auditLogPath string
+	// auditVerifyKey validates the hash chain's Ed25519 signatures in
+	// auditVerifyHandler. It's nil (signatures go unchecked) unless
+	// AUDIT_SIGNING_KEY is set.
+	auditVerifyKey ed25519.PublicKey
+
+	// authVerifier validates OAuth2 bearer tokens for the routes
+	// enumerated in setupRoutes. It's nil (and those routes left open)
+	// unless OAUTH_JWKS_URL is set, so existing deployments that haven't
+	// stood up an OIDC provider yet keep working unchanged.
+	authVerifier *auth.Verifier
+
+	// snapshotStore holds the artifact bytes runSnapshotJob/runReplayJob
+	// and snapshotDownloadHandler read and write; container.SnapshotRepo
+	// only holds the manifests pointing into it.
+	snapshotStore snapshot.Store
+
+	// bundleSigningKey signs the bundle returned by GET
+	// /policies/bundles/current. It's nil (and that endpoint disabled)
+	// unless POLICY_BUNDLE_SIGNING_KEY is set.
+	bundleSigningKey ed25519.PrivateKey
+
+	// bundleTrustedKeys verifies bundles submitted to POST /policies/bundles
+	// and bundles fetched by bundlePoller. It's empty (and both disabled)
+	// unless POLICY_BUNDLE_TRUSTED_KEYS is set.
+	bundleTrustedKeys []ed25519.PublicKey
+
+	// bundlePoller pulls signed bundles from a GitOps endpoint on an
+	// interval. It's nil unless POLICY_BUNDLE_POLL_URL is set.
+	bundlePoller *PolicyBundlePoller
+
+	// grpcServer serves gRPC alongside the Gin HTTP API, sharing
+	// authVerifier for bearer token checks. No service implementations
+	// are registered on it, so it answers every RPC as unimplemented.
+	// It's nil (and disabled) unless both OAUTH_JWKS_URL and GRPC_PORT
+	// are set.
+	grpcServer *grpc.Server
 }
 
-func NewControlPlane() *ControlPlane {
+// authChain returns {Authenticate} followed by the given middlewares when
+// OAuth is configured, or no middlewares at all otherwise, so routes can
+// do api.POST("/tenants", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), handler)...)
+// without special-casing the unconfigured case.
+func (cp *ControlPlane) authChain(rest ...gin.HandlerFunc) []gin.HandlerFunc {
+	if cp.authVerifier == nil {
+		return nil
+	}
+	return append([]gin.HandlerFunc{httpmiddleware.Authenticate(cp.authVerifier)}, rest...)
+}
+
+func NewControlPlane() (*ControlPlane, error) {
 	client := resty.New().
 		SetTimeout(5 * time.Second).
 		SetBaseURL(CoreServiceURL)
 
-	router := gin.Default()
+	// Load the logging pipeline before anything else logs, so audit
+	// failures, Gin access logs, and policy decisions all share it.
+	logCfg := logging.Config{Default: "info", Console: &logging.ConsoleConfig{Color: true}}
+	if logCfgPath := os.Getenv("LOG_CONFIG_PATH"); logCfgPath != "" {
+		loaded, err := logging.LoadConfig(logCfgPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load logging config: %w", err)
+		}
+		logCfg = *loaded
+	}
+	logRegistry, err := logging.NewRegistry(logCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize logging: %w", err)
+	}
+	logging.SetDefault(logRegistry)
+
+	// gin.New instead of gin.Default: GinAccessLogMiddleware and
+	// RecoveryMiddleware below replace gin's built-in logger and recovery
+	// middleware with ones routed through the shared logging pipeline.
+	router := gin.New()
 
 	// Initialize metrics
 	metrics := NewMetrics()
@@ -56,18 +160,213 @@ func NewControlPlane() *ControlPlane {
 	// Initialize Clean Architecture container
 	container := internal.NewContainer()
 
+	auditLogPath := os.Getenv("AUDIT_LOG_PATH")
+	if auditLogPath == "" {
+		auditLogPath = "audit.log"
+	}
+	auditSigningKey := newAuditSigningKey()
+	auditLogger, err := NewAuditLogger(AuditConfig{
+		FilePath:   auditLogPath,
+		SigningKey: auditSigningKey,
+		Context: &ContextConfig{
+			CaptureResources: []string{"tenant", "backup"},
+			RedactFields:     []string{"password", "token", "secret", "api_key", "ssn"},
+			DenyPaths:        []string{"/api/v1/auth/login"},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize audit logger: %w", err)
+	}
+
+	bundleTrustedKeys := newBundleTrustedKeys()
+
+	authVerifier := newOAuthVerifier()
+
 	cp := &ControlPlane{
-		client:    client,
-		router:    router,
-		metrics:   metrics,
-		container: container,
+		client:            client,
+		router:            router,
+		metrics:           metrics,
+		container:         container,
+		policyEngine:      NewPolicyEngine(),
+		auditLogger:       auditLogger,
+		logRegistry:       logRegistry,
+		auditLogPath:      auditLogPath,
+		auditVerifyKey:    auditVerifyPublicKey(auditSigningKey),
+		authVerifier:      authVerifier,
+		snapshotStore:     newSnapshotStore(),
+		bundleSigningKey:  newBundleSigningKey(),
+		bundleTrustedKeys: bundleTrustedKeys,
+		grpcServer:        newGRPCServer(authVerifier),
+	}
+
+	if bundleURL := os.Getenv("POLICY_BUNDLE_POLL_URL"); bundleURL != "" {
+		interval := DefaultBundlePollInterval
+		if raw := os.Getenv("POLICY_BUNDLE_POLL_INTERVAL"); raw != "" {
+			if parsed, err := time.ParseDuration(raw); err == nil {
+				interval = parsed
+			}
+		}
+		cp.bundlePoller = NewPolicyBundlePoller(bundleURL, interval, cp.policyEngine, bundleTrustedKeys)
 	}
 
+	// Access log outermost, so it captures every request's final status
+	// (including ones RecoveryMiddleware turns into a 500) and duration.
+	router.Use(GinAccessLogMiddleware(logging.Get("http")))
+
+	// Recover from panics ahead of Prometheus middleware; a request that
+	// panics skips Prometheus's recording and is counted in PanicsTotal
+	router.Use(RecoveryMiddleware(metrics, container.AuditRepo))
+
 	// Add Prometheus middleware
 	router.Use(PrometheusMiddleware(metrics))
 
+	// AuditMiddleware wraps PolicyEnforcementMiddleware so every request
+	// (including ones PolicyEnforcementMiddleware denies) gets logged, with
+	// the resulting policy decision folded into AuditEvent.Metadata.
+	router.Use(AuditMiddleware(auditLogger))
+	router.Use(PolicyEnforcementMiddleware(container.PolicySet))
+
+	// Innermost chain, closest to the handlers: request-ID propagation,
+	// a timeout guard, sanitized request/response logging, and panic
+	// recovery. Recovery is registered last (innermost) so its defer runs
+	// in the same goroutine RequestTimeout spawns to run the rest of the
+	// chain, and can still catch a panic from AccessLog or any handler,
+	// including PolicyHandler.Evaluate.
+	router.Use(httpmiddleware.RequestID())
+	router.Use(httpmiddleware.RequestTimeout(RequestTimeout))
+	router.Use(httpmiddleware.AccessLog(logging.Get("http")))
+	router.Use(httpmiddleware.Recovery(logging.Get("http")))
+
+	// Register the handlers that actually perform snapshot/replay work;
+	// snapshotHandler/replayHandler only enqueue the Job.
+	container.JobDispatcher.Register(entities.JobTypeSnapshot, cp.runSnapshotJob)
+	container.JobDispatcher.Register(entities.JobTypeReplay, cp.runReplayJob)
+	container.JobDispatcher.Register(entities.JobTypePolicyEvaluation, cp.runPolicyEvaluationJob)
+
 	cp.setupRoutes()
-	return cp
+	return cp, nil
+}
+
+// newOAuthVerifier builds an auth.Verifier from OAUTH_JWKS_URL/
+// OAUTH_ISSUER/OAUTH_AUDIENCE, or returns nil if OAUTH_JWKS_URL is unset,
+// leaving the routes authChain guards apply to open.
+func newOAuthVerifier() *auth.Verifier {
+	jwksURL := os.Getenv("OAUTH_JWKS_URL")
+	if jwksURL == "" {
+		return nil
+	}
+	return auth.NewVerifier(auth.Config{
+		Issuer:   os.Getenv("OAUTH_ISSUER"),
+		Audience: os.Getenv("OAUTH_AUDIENCE"),
+		JWKSURL:  jwksURL,
+	})
+}
+
+// newGRPCServer builds the gRPC gateway from GRPC_PORT, or returns nil
+// (leaving it disabled) if GRPC_PORT is unset or verifier is nil, since
+// the gateway's Auth interceptor has nothing to verify tokens against
+// without an OAuth verifier configured.
+func newGRPCServer(verifier *auth.Verifier) *grpc.Server {
+	if os.Getenv("GRPC_PORT") == "" || verifier == nil {
+		return nil
+	}
+	return controlplanegrpc.NewServer(verifier)
+}
+
+// newSnapshotStore builds the snapshot.Store backing snapshotHandler,
+// replayHandler, and snapshotDownloadHandler: an S3Store when
+// SNAPSHOT_S3_BUCKET is set, a LocalStore under SNAPSHOT_LOCAL_DIR (or
+// DefaultSnapshotLocalDir) otherwise, so deployments without S3
+// configured still get a working snapshot/replay cycle against disk.
+func newSnapshotStore() snapshot.Store {
+	bucket := os.Getenv("SNAPSHOT_S3_BUCKET")
+	if bucket == "" {
+		dir := os.Getenv("SNAPSHOT_LOCAL_DIR")
+		if dir == "" {
+			dir = DefaultSnapshotLocalDir
+		}
+		return snapshot.NewLocalStore(dir)
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "snapshot store: failed to load AWS config, falling back to local storage: %v\n", err)
+		return snapshot.NewLocalStore(DefaultSnapshotLocalDir)
+	}
+
+	return snapshot.NewS3Store(s3.NewFromConfig(awsCfg), bucket, os.Getenv("SNAPSHOT_S3_PREFIX"))
+}
+
+// newBundleSigningKey decodes POLICY_BUNDLE_SIGNING_KEY, a base64-encoded
+// Ed25519 seed, into the private key GET /policies/bundles/current signs
+// with. It returns nil (disabling that endpoint) if the variable is unset
+// or malformed.
+func newBundleSigningKey() ed25519.PrivateKey {
+	raw := os.Getenv("POLICY_BUNDLE_SIGNING_KEY")
+	if raw == "" {
+		return nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		fmt.Fprintf(os.Stderr, "policy bundle signing key: POLICY_BUNDLE_SIGNING_KEY must be a base64-encoded %d-byte Ed25519 seed, disabling signed bundle export\n", ed25519.SeedSize)
+		return nil
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+// newBundleTrustedKeys decodes POLICY_BUNDLE_TRUSTED_KEYS, a comma-separated
+// list of base64-encoded Ed25519 public keys, into the set LoadSignedBundle
+// verifies incoming bundles against. Malformed entries are skipped with a
+// warning rather than failing startup, since dropping one bad key out of
+// several shouldn't disable the rest.
+func newBundleTrustedKeys() []ed25519.PublicKey {
+	raw := os.Getenv("POLICY_BUNDLE_TRUSTED_KEYS")
+	if raw == "" {
+		return nil
+	}
+
+	var keys []ed25519.PublicKey
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(entry)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			fmt.Fprintf(os.Stderr, "policy bundle trusted keys: skipping malformed Ed25519 public key %q\n", entry)
+			continue
+		}
+		keys = append(keys, ed25519.PublicKey(key))
+	}
+	return keys
+}
+
+// newAuditSigningKey decodes AUDIT_SIGNING_KEY, the base64-encoded
+// Ed25519 seed FileAuditSink signs its hash chain with, or returns nil
+// (leaving the chain unsigned) if unset or malformed.
+func newAuditSigningKey() ed25519.PrivateKey {
+	raw := os.Getenv("AUDIT_SIGNING_KEY")
+	if raw == "" {
+		return nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		fmt.Fprintf(os.Stderr, "audit signing key: AUDIT_SIGNING_KEY must be a base64-encoded %d-byte Ed25519 seed, disabling chain signing\n", ed25519.SeedSize)
+		return nil
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+// auditVerifyPublicKey extracts the public half of signingKey for
+// auditVerifyHandler to check signatures against, or nil if signingKey
+// is nil.
+func auditVerifyPublicKey(signingKey ed25519.PrivateKey) ed25519.PublicKey {
+	if signingKey == nil {
+		return nil
+	}
+	return signingKey.Public().(ed25519.PublicKey)
 }
 
 func (cp *ControlPlane) setupRoutes() {
@@ -83,20 +382,56 @@ func (cp *ControlPlane) setupRoutes() {
 	{
 		api.GET("/cluster/status", cp.clusterStatusHandler)
 		api.GET("/metrics/json", cp.metricsHandler)
-		api.POST("/operations/snapshot", cp.snapshotHandler)
-		api.POST("/operations/replay", cp.replayHandler)
+		api.POST("/operations/snapshot", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.snapshotHandler)...)
+		api.POST("/operations/replay", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.replayHandler)...)
+		api.GET("/operations/snapshots", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.SnapshotHandler.List)...)
+		api.GET("/operations/snapshots/:id", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.SnapshotHandler.Get)...)
+		api.GET("/operations/snapshots/:id/download", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.snapshotDownloadHandler)...)
+		api.POST("/jobs", cp.container.JobHandler.Create)
+		api.GET("/jobs", cp.container.JobHandler.List)
+		api.GET("/jobs/:id", cp.container.JobHandler.Get)
+		api.POST("/jobs/:id/cancel", cp.container.JobHandler.Cancel)
+		api.GET("/jobs/:id/executions", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.JobHandler.Executions)...)
+		api.GET("/policies/bundle", cp.policyBundleExportHandler)
+		api.POST("/policies/bundle", cp.policyBundleImportHandler)
+		api.POST("/policies/bundles", cp.policyBundlesCreateHandler)
+		api.GET("/policies/bundles/current", cp.policyBundlesCurrentHandler)
+		api.POST("/policies/batch-simulate", cp.policyBatchSimulateHandler)
+		api.GET("/policies/coverage", cp.policyCoverageHandler)
+		api.GET("/audit/verify", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.auditVerifyHandler)...)
 
 		// Clean Architecture endpoints
-		api.POST("/tenants", cp.container.TenantHandler.Create)
-		api.POST("/policies/evaluate", cp.container.PolicyHandler.Evaluate)
+		api.POST("/tenants", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.TenantHandler.Create)...)
+		api.POST("/tenants/:id/restore", append(cp.authChain(), cp.container.TenantHandler.Restore)...)
+		api.POST("/policies/evaluate", append(cp.authChain(), cp.container.PolicyHandler.Evaluate)...)
+		api.POST("/policies/simulate", cp.container.PolicyHandler.Simulate)
+		api.POST("/policies/dry-run", cp.container.PolicyHandler.DryRun)
+		api.GET("/policies", cp.container.PolicyHandler.List)
+		api.GET("/policies/:id", cp.container.PolicyHandler.Get)
+		api.POST("/policies", cp.container.PolicyHandler.Create)
+		api.PUT("/policies/:id", cp.container.PolicyHandler.Update)
+		api.DELETE("/policies/:id", cp.container.PolicyHandler.Delete)
+		api.POST("/policies/:id/enable", cp.container.PolicyHandler.Enable)
+		api.POST("/policies/:id/disable", cp.container.PolicyHandler.Disable)
+		api.GET("/policies/:id/bindings", cp.container.PolicyHandler.Bindings)
+		api.POST("/policies/:id/unbind", cp.container.PolicyHandler.Unbind)
+		api.POST("/policies/test", cp.container.PolicyHandler.Test)
+
+		api.POST("/replication/targets", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.ReplicationHandler.CreateTarget)...)
+		api.GET("/replication/targets", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.ReplicationHandler.ListTargets)...)
+		api.POST("/replication/policies", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.ReplicationHandler.CreatePolicy)...)
+		api.GET("/replication/policies", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.ReplicationHandler.ListPolicies)...)
+		api.POST("/replication/policies/:id/trigger", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.ReplicationHandler.Trigger)...)
+		api.GET("/replication/executions", append(cp.authChain(httpmiddleware.RequireRole(string(entities.RoleAdmin))), cp.container.ReplicationHandler.ListExecutions)...)
+		api.POST("/replication/ingest", cp.replicationIngestHandler)
 	}
 }
 
 func (cp *ControlPlane) healthHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
-		"status":  "healthy",
-		"service": "allsource-control-plane",
-		"version": "0.1.0",
+		"status":    "healthy",
+		"service":   "allsource-control-plane",
+		"version":   "0.1.0",
 		"timestamp": time.Now().UTC(),
 	})
 }
@@ -105,6 +440,7 @@ func (cp *ControlPlane) coreHealthHandler(c *gin.Context) {
 	start := time.Now()
 	resp, err := cp.client.R().Get("/health")
 	duration := time.Since(start).Seconds()
+	RecordDownstreamCall(c, "GET core:/health")
 
 	cp.metrics.CoreHealthCheckDuration.Observe(duration)
 
@@ -120,10 +456,7 @@ func (cp *ControlPlane) coreHealthHandler(c *gin.Context) {
 	var result map[string]interface{}
 	if err := json.Unmarshal(resp.Body(), &result); err != nil {
 		cp.metrics.CoreHealthCheckTotal.WithLabelValues("error").Inc()
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status": "error",
-			"error":  "failed to parse core response",
-		})
+		httpx.WriteError(c, domain.Wrap(err, domain.CodeInternal, "failed to parse core response"))
 		return
 	}
 
@@ -134,6 +467,7 @@ func (cp *ControlPlane) coreHealthHandler(c *gin.Context) {
 func (cp *ControlPlane) clusterStatusHandler(c *gin.Context) {
 	// Get core stats
 	resp, err := cp.client.R().Get("/api/v1/stats")
+	RecordDownstreamCall(c, "GET core:/api/v1/stats")
 
 	var coreStats map[string]interface{}
 	if err == nil {
@@ -151,15 +485,16 @@ func (cp *ControlPlane) clusterStatusHandler(c *gin.Context) {
 				"stats":  coreStats,
 			},
 		},
-		"total_nodes":    1,
-		"healthy_nodes":  1,
-		"timestamp":      time.Now().UTC(),
+		"total_nodes":   1,
+		"healthy_nodes": 1,
+		"timestamp":     time.Now().UTC(),
 	})
 }
 
 func (cp *ControlPlane) metricsHandler(c *gin.Context) {
 	// Aggregate metrics from core
 	resp, err := cp.client.R().Get("/api/v1/stats")
+	RecordDownstreamCall(c, "GET core:/api/v1/stats")
 
 	if err != nil {
 		c.JSON(http.StatusServiceUnavailable, gin.H{
@@ -175,7 +510,7 @@ func (cp *ControlPlane) metricsHandler(c *gin.Context) {
 		"metrics": gin.H{
 			"event_store": stats,
 			"control_plane": gin.H{
-				"uptime_seconds": time.Since(startTime).Seconds(),
+				"uptime_seconds":   time.Since(startTime).Seconds(),
 				"requests_handled": 0, // Would track this in production
 			},
 		},
@@ -183,48 +518,412 @@ func (cp *ControlPlane) metricsHandler(c *gin.Context) {
 	})
 }
 
+// snapshotHandler enqueues a snapshot Job and returns it for polling via
+// GET /api/v1/jobs/:id, rather than performing the snapshot inline. The
+// tenant the snapshot is scoped to comes from the authenticated principal,
+// falling back to an explicit tenant_id in the body for admins (or when no
+// Authenticate middleware is wired in front of this route).
 func (cp *ControlPlane) snapshotHandler(c *gin.Context) {
-	// Track snapshot operation
-	cp.metrics.SnapshotOperationsTotal.Inc()
+	var req struct {
+		TenantID string `json:"tenant_id"`
+	}
+	_ = c.ShouldBindJSON(&req)
 
-	// Simulate snapshot creation
-	snapshotID := fmt.Sprintf("snapshot-%d", time.Now().Unix())
+	if rc := httpmiddleware.RequestContextFrom(c); rc != nil && !rc.HasRole(string(entities.RoleAdmin)) {
+		req.TenantID = rc.TenantID
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"snapshot_id": snapshotID,
-		"status":      "created",
-		"timestamp":   time.Now().UTC(),
-		"message":     "Snapshot created successfully (demo mode)",
+	resp, err := cp.container.CreateJobUC.Execute(dto.CreateJobRequest{
+		Type:    string(entities.JobTypeSnapshot),
+		Options: map[string]interface{}{"tenant_id": req.TenantID},
 	})
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
 }
 
+// replayHandler enqueues a replay Job and returns it for polling via
+// GET /api/v1/jobs/:id, rather than performing the replay inline.
+// SnapshotID, if set, makes runReplayJob stream that snapshot's artifact
+// back into the core service; without it the job sends nothing to core.
 func (cp *ControlPlane) replayHandler(c *gin.Context) {
 	var req struct {
-		EntityID string     `json:"entity_id"`
-		AsOf     *time.Time `json:"as_of"`
+		EntityID   string     `json:"entity_id"`
+		AsOf       *time.Time `json:"as_of"`
+		SnapshotID string     `json:"snapshot_id"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httpx.WriteError(c, err)
 		return
 	}
 
-	// Track replay operation
-	cp.metrics.ReplayOperationsTotal.Inc()
+	options := map[string]interface{}{"entity_id": req.EntityID}
+	if req.AsOf != nil {
+		options["as_of"] = req.AsOf
+	}
+	if req.SnapshotID != "" {
+		options["snapshot_id"] = req.SnapshotID
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":    "replay_initiated",
-		"entity_id": req.EntityID,
-		"as_of":     req.AsOf,
-		"timestamp": time.Now().UTC(),
-		"message":   "Event replay initiated (demo mode)",
+	resp, err := cp.container.CreateJobUC.Execute(dto.CreateJobRequest{
+		Type:    string(entities.JobTypeReplay),
+		Options: options,
 	})
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// snapshotDownloadHandler handles GET /api/v1/operations/snapshots/:id/download.
+// Unlike SnapshotHandler.List/Get, it reaches past SnapshotRepo into the
+// raw snapshotStore: it redirects to a presigned URL when the store can
+// produce one (S3Store), or streams the artifact through itself otherwise
+// (LocalStore).
+func (cp *ControlPlane) snapshotDownloadHandler(c *gin.Context) {
+	manifest, err := cp.container.SnapshotRepo.FindByID(c.Param("id"))
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+
+	if url, err := cp.snapshotStore.DownloadURL(c.Request.Context(), manifest.TenantID, manifest.ID, DefaultSnapshotDownloadURLExpiry); err == nil && url != "" {
+		c.Redirect(http.StatusFound, url)
+		return
+	}
+
+	body, err := cp.snapshotStore.Get(c.Request.Context(), manifest.TenantID, manifest.ID)
+	if err != nil {
+		httpx.WriteError(c, err)
+		return
+	}
+	defer body.Close()
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.jsonl.gz"`, manifest.ID))
+	c.DataFromReader(http.StatusOK, manifest.SizeBytes, "application/gzip", body, nil)
+}
+
+// runSnapshotJob is the JobHandler registered for JobTypeSnapshot; it does
+// the work snapshotHandler used to do inline: pull the core service's
+// event export, gzip it, write it to cp.snapshotStore, and record the
+// result as a SnapshotManifest keyed by job.ID.
+func (cp *ControlPlane) runSnapshotJob(ctx context.Context, job *entities.Job) error {
+	cp.metrics.SnapshotOperationsTotal.Inc()
+
+	tenantID, _ := job.Options["tenant_id"].(string)
+
+	resp, err := cp.client.R().SetContext(ctx).Get("/api/v1/events/export")
+	if err != nil {
+		return fmt.Errorf("fetch event export from core: %w", err)
+	}
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	if _, err := zw.Write(resp.Body()); err != nil {
+		return fmt.Errorf("gzip event export: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("gzip event export: %w", err)
+	}
+
+	sizeBytes, sha256Hex, err := cp.snapshotStore.Put(ctx, tenantID, job.ID, &gz)
+	if err != nil {
+		return fmt.Errorf("store snapshot artifact: %w", err)
+	}
+
+	manifest := entities.NewSnapshotManifest(job.ID, tenantID, sizeBytes, sha256Hex, resp.Header().Get("X-Event-Offset"))
+	if err := cp.container.SnapshotRepo.Save(manifest); err != nil {
+		return fmt.Errorf("save snapshot manifest: %w", err)
+	}
+
+	return nil
+}
+
+// runReplayJob is the JobHandler registered for JobTypeReplay; it does the
+// work replayHandler used to do inline. With a snapshot_id option it
+// streams that snapshot's artifact back into the core service; with none
+// it only counts the replay in ReplayOperationsTotal and returns without
+// contacting the core service.
+func (cp *ControlPlane) runReplayJob(ctx context.Context, job *entities.Job) error {
+	cp.metrics.ReplayOperationsTotal.Inc()
+
+	snapshotID, _ := job.Options["snapshot_id"].(string)
+	if snapshotID == "" {
+		return nil
+	}
+
+	manifest, err := cp.container.SnapshotRepo.FindByID(snapshotID)
+	if err != nil {
+		return fmt.Errorf("look up snapshot %s: %w", snapshotID, err)
+	}
+
+	artifact, err := cp.snapshotStore.Get(ctx, manifest.TenantID, manifest.ID)
+	if err != nil {
+		return fmt.Errorf("open snapshot %s: %w", snapshotID, err)
+	}
+	defer artifact.Close()
+
+	zr, err := gzip.NewReader(artifact)
+	if err != nil {
+		return fmt.Errorf("decompress snapshot %s: %w", snapshotID, err)
+	}
+	defer zr.Close()
+
+	if _, err := cp.client.R().SetContext(ctx).SetBody(zr).Post("/api/v1/events/import"); err != nil {
+		return fmt.Errorf("stream snapshot %s into core: %w", snapshotID, err)
+	}
+
+	return nil
+}
+
+// runPolicyEvaluationJob is the JobHandler registered for
+// JobTypePolicyEvaluation: it dry-runs every enabled policy against the
+// current tenant/user set and reports which actions would be denied,
+// without affecting live enforcement. The report is written to the
+// in-flight JobExecution's stdout (see workers.ExecutionFromContext) so
+// operators can review it via GET /api/v1/jobs/{id}/executions.
+func (cp *ControlPlane) runPolicyEvaluationJob(ctx context.Context, job *entities.Job) error {
+	policies, err := cp.container.PolicyRepo.FindEnabled()
+	if err != nil {
+		return fmt.Errorf("list enabled policies: %w", err)
+	}
+
+	tenants, err := cp.container.TenantRepo.FindActive()
+	if err != nil {
+		return fmt.Errorf("list active tenants: %w", err)
+	}
+
+	var report strings.Builder
+	denied := 0
+	fmt.Fprintf(&report, "policy evaluation job %s: %d enabled policies, %d active tenants\n", job.ID, len(policies), len(tenants))
+
+	for _, tenant := range tenants {
+		users, err := cp.container.UserRepo.FindByTenant(tenant.ID)
+		if err != nil {
+			fmt.Fprintf(&report, "tenant %s: list users: %v\n", tenant.ID, err)
+			continue
+		}
+
+		for _, user := range users {
+			attrs := map[string]interface{}{
+				"tenant_id": tenant.ID,
+				"user_id":   user.ID,
+				"role":      string(user.Role),
+			}
+
+			for _, policy := range policies {
+				matched, err := policy.Evaluate(attrs)
+				if err != nil {
+					fmt.Fprintf(&report, "policy %s: tenant %s user %s: %v\n", policy.ID, tenant.ID, user.ID, err)
+					continue
+				}
+				if matched && policy.Action == entities.ActionDeny {
+					denied++
+					fmt.Fprintf(&report, "would deny: tenant=%s user=%s policy=%s resource=%s\n", tenant.ID, user.ID, policy.ID, policy.Resource)
+				}
+			}
+		}
+	}
+
+	fmt.Fprintf(&report, "%d would-be-denied actions found\n", denied)
+
+	if execution, ok := workers.ExecutionFromContext(ctx); ok {
+		execution.Stdout = report.String()
+	}
+
+	return nil
+}
+
+// policyBundleExportHandler handles GET /api/v1/policies/bundle
+func (cp *ControlPlane) policyBundleExportHandler(c *gin.Context) {
+	c.Header("Content-Type", "application/gzip")
+	c.Header("Content-Disposition", `attachment; filename="policy-bundle.tar.gz"`)
+
+	if err := cp.policyEngine.ExportBundle(c.Writer); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+}
+
+// policyBundleImportHandler handles POST /api/v1/policies/bundle
+func (cp *ControlPlane) policyBundleImportHandler(c *gin.Context) {
+	mode := ImportMode(c.DefaultQuery("mode", string(ImportModeMerge)))
+	switch mode {
+	case ImportModeMerge, ImportModeReplace, ImportModeDryRun:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown import mode %q", mode)})
+		return
+	}
+
+	report, err := cp.policyEngine.ImportBundle(c.Request.Body, mode)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// policyBundlesCreateHandler handles POST /api/v1/policies/bundles: it
+// accepts a SignedBundle body, verifies it against bundleTrustedKeys, and,
+// if the signature checks out and the revision isn't stale, atomically
+// replaces the engine's ruleset.
+func (cp *ControlPlane) policyBundlesCreateHandler(c *gin.Context) {
+	if len(cp.bundleTrustedKeys) == 0 {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "POLICY_BUNDLE_TRUSTED_KEYS is not configured"})
+		return
+	}
+
+	if err := cp.policyEngine.LoadSignedBundle(c.Request.Body, cp.bundleTrustedKeys); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revision": cp.policyEngine.CurrentRevision()})
+}
+
+// policyBundlesCurrentHandler handles GET /api/v1/policies/bundles/current:
+// it returns the engine's current policies as a SignedBundle at its
+// current revision, signed with bundleSigningKey.
+func (cp *ControlPlane) policyBundlesCurrentHandler(c *gin.Context) {
+	if cp.bundleSigningKey == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "POLICY_BUNDLE_SIGNING_KEY is not configured"})
+		return
+	}
+
+	data, err := cp.policyEngine.ExportSignedBundle(cp.policyEngine.CurrentRevision(), cp.bundleSigningKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json", data)
+}
+
+// replicationIngestHandler handles POST /api/v1/replication/ingest. It
+// authenticates by the pushed replication.Bundle's own Ed25519 signature
+// rather than a bearer token, so (like policyBundleImportHandler) it isn't
+// behind authChain; reaching into ReplicationReceiver's signature
+// verification is infrastructure beyond what a use case exposes, so like
+// snapshotDownloadHandler this is implemented directly on ControlPlane
+// instead of ReplicationHandler.
+func (cp *ControlPlane) replicationIngestHandler(c *gin.Context) {
+	var bundle replication.Bundle
+	if err := c.ShouldBindJSON(&bundle); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	applied, err := cp.container.ReplicationReceiver.Apply(bundle)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"applied": applied})
+}
+
+// policyBatchSimulateHandler handles POST /api/v1/policies/batch-simulate:
+// it runs cp.policyEngine.Simulate against a batch of PolicyContext records
+// and returns, for each, the full SimulationResult (decision, winning
+// policy, and every policy considered along the way). Unlike
+// /policies/evaluate, this never affects PolicyEngine's revision or
+// ruleset; it's read-only, for testing a set of requests against the
+// current rules before they're sent for real.
+func (cp *ControlPlane) policyBatchSimulateHandler(c *gin.Context) {
+	var req struct {
+		Contexts []PolicyContext `json:"contexts"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results := make([]SimulationResult, len(req.Contexts))
+	for i, ctx := range req.Contexts {
+		results[i] = cp.policyEngine.Simulate(ctx)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// policyCoverageHandler handles GET /api/v1/policies/coverage: it reports,
+// over the last ?n (default hitRingCapacity, the most this control plane
+// instance retains) policy evaluation outcomes, how many times each
+// currently-loaded policy matched, denied, or warned, and flags any policy
+// that never fired so operators can find dead rules.
+func (cp *ControlPlane) policyCoverageHandler(c *gin.Context) {
+	n := 0
+	if raw := c.Query("n"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "n must be a non-negative integer"})
+			return
+		}
+		n = parsed
+	}
+
+	c.JSON(http.StatusOK, gin.H{"coverage": PolicyCoverage(cp.policyEngine, cp.auditLogger, n)})
+}
+
+// auditVerifyHandler handles GET /api/v1/audit/verify: it walks the
+// hash-chained audit log at cp.auditLogPath, recomputing each record's
+// hash (and Ed25519 signature, if AUDIT_SIGNING_KEY is configured) to
+// confirm the chain hasn't been tampered with. By default it verifies
+// from the start of the file; passing ?from_hash=<chain_head> resumes
+// from the record immediately after that hash instead of rescanning
+// everything already verified by a prior call. ?limit caps how many
+// records are verified in one call (0, the default, for no limit).
+func (cp *ControlPlane) auditVerifyHandler(c *gin.Context) {
+	fromHash := c.Query("from_hash")
+
+	limit := 0
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a non-negative integer"})
+			return
+		}
+		limit = parsed
+	}
+
+	events, err := VerifyChain(cp.auditLogPath, fromHash, limit, cp.auditVerifyKey)
+	if err != nil {
+		c.JSON(http.StatusConflict, gin.H{
+			"verified": len(events),
+			"error":    err.Error(),
+		})
+		return
+	}
+
+	chainHead := fromHash
+	if len(events) > 0 {
+		chainHead = events[len(events)-1].Hash
+	}
+	c.JSON(http.StatusOK, gin.H{"verified": len(events), "chain_head": chainHead})
 }
 
 func (cp *ControlPlane) Start(port string) error {
 	return cp.router.Run(":" + port)
 }
 
+// StartGRPC listens on GRPC_PORT and serves cp.grpcServer until it's
+// stopped or the listener fails. Callers should only invoke this when
+// cp.grpcServer is non-nil.
+func (cp *ControlPlane) StartGRPC(port string) error {
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return fmt.Errorf("grpc: listen on port %s: %w", port, err)
+	}
+	return cp.grpcServer.Serve(lis)
+}
+
 var startTime time.Time
 
 func main() {
@@ -237,7 +936,32 @@ func main() {
 		port = DefaultPort
 	}
 
-	cp := NewControlPlane()
+	cp, err := NewControlPlane()
+	if err != nil {
+		log.Fatalf("Failed to initialize control plane: %v", err)
+	}
+
+	// Start the tenant purge worker; it runs until purgeCancel is called
+	// during shutdown.
+	purgeCtx, purgeCancel := context.WithCancel(context.Background())
+	go cp.container.TenantPurgeWorker.Run(purgeCtx)
+
+	// Start the job dispatcher's worker pool and cron scheduling loop; it
+	// runs until jobCancel is called during shutdown.
+	jobCtx, jobCancel := context.WithCancel(context.Background())
+	go cp.container.JobDispatcher.Run(jobCtx)
+
+	// Start the signed policy bundle poller, if POLICY_BUNDLE_POLL_URL was
+	// configured; it runs until bundlePollCancel is called during shutdown.
+	bundlePollCtx, bundlePollCancel := context.WithCancel(context.Background())
+	if cp.bundlePoller != nil {
+		go cp.bundlePoller.Run(bundlePollCtx)
+	}
+
+	// Start the replication scheduler's cron runner and rescan loop; it
+	// runs until replicationCancel is called during shutdown.
+	replicationCtx, replicationCancel := context.WithCancel(context.Background())
+	go cp.container.ReplicationScheduler.Run(replicationCtx)
 
 	// Graceful shutdown
 	srv := &http.Server{
@@ -252,12 +976,28 @@ func main() {
 		}
 	}()
 
+	// Start the gRPC gateway, if GRPC_PORT/OAUTH_JWKS_URL were configured.
+	if cp.grpcServer != nil {
+		grpcPort := os.Getenv("GRPC_PORT")
+		go func() {
+			log.Printf("ðŸš€ gRPC gateway listening on port %s\n", grpcPort)
+			if err := cp.StartGRPC(grpcPort); err != nil {
+				log.Fatalf("gRPC server failed: %v", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down gracefully...")
+	purgeCancel()
+	jobCancel()
+	bundlePollCancel()
+	replicationCancel()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -265,5 +1005,16 @@ func main() {
 		log.Fatal("Server forced to shutdown:", err)
 	}
 
+	if cp.grpcServer != nil {
+		cp.grpcServer.GracefulStop()
+	}
+
+	if err := cp.auditLogger.Close(); err != nil {
+		log.Printf("Failed to close audit logger cleanly: %v", err)
+	}
+	if err := cp.logRegistry.Close(); err != nil {
+		log.Printf("Failed to close logging pipeline cleanly: %v", err)
+	}
+
 	log.Println("Control Plane stopped")
 }
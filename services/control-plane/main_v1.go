@@ -2,48 +2,119 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/allsource/control-plane/internal/application/usecases"
+	"github.com/allsource/control-plane/internal/cluster"
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/allsource/control-plane/internal/infrastructure/persistence"
+	"github.com/allsource/control-plane/internal/quotas"
 	"github.com/gin-gonic/gin"
 	"github.com/go-resty/resty/v2"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
 )
 
 const (
 	DefaultPort    = "8081"
 	CoreServiceURL = "http://localhost:8080"
 	Version        = "1.0.0"
+
+	// DefaultApprovalThreshold is how many distinct admins must approve an
+	// AccessRequest before it runs, when APPROVAL_THRESHOLD is unset.
+	DefaultApprovalThreshold = 2
+
+	// AccessRequestTTL is how long an AccessRequest stays PENDING before
+	// the background reaper marks it EXPIRED.
+	AccessRequestTTL = 24 * time.Hour
 )
 
 type ControlPlaneV1 struct {
-	client      *resty.Client
-	router      *gin.Engine
-	metrics     *ControlPlaneMetrics
-	authClient  *AuthClient
-	auditLogger *AuditLogger
+	client             *resty.Client
+	router             *gin.Engine
+	metrics            *ControlPlaneMetrics
+	authClient         *AuthClient
+	auditLogger        *AuditLogger
+	peerNotifier       *cluster.PeerNotifier
+	peerTrustedKeys    []ed25519.PublicKey
+	accessRequestRepo  repositories.AccessRequestRepository
+	approvalThreshold  int
+	stopReaper         chan struct{}
+	tracingEnabled     bool
+	tracingShutdown    func(context.Context) error
+	quotaLimiter       *quotas.Limiter
+	auditRepo          repositories.AuditRepository
+	auditRepoClose     func()
+	appRoleRepo        *persistence.MemoryAppRoleRepository
+	jwtSigningSecret   []byte
+	createAppRoleUC    *usecases.CreateAppRoleUseCase
+	rotateRoleIDUC     *usecases.RotateRoleIDUseCase
+	generateSecretIDUC *usecases.GenerateSecretIDUseCase
+	loginWithAppRoleUC *usecases.LoginWithAppRoleUseCase
+	auditQueryUC       *usecases.AuditQueryUseCase
 }
 
 func NewControlPlaneV1() (*ControlPlaneV1, error) {
-	// Initialize auth client
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		log.Println("⚠️  JWT_SECRET not set, using default (INSECURE for production)")
-		jwtSecret = "default-secret-change-in-production"
+	// Initialize auth client. JWKS_URL, if set, switches verification from
+	// a pre-shared HMAC secret to an OIDC provider's rotating RS256/ES256
+	// key set, so a deployment fronted by Keycloak/Auth0/Google doesn't
+	// need to share a symmetric secret with this service.
+	var authClient *AuthClient
+	var err error
+	if jwksURL := os.Getenv("JWKS_URL"); jwksURL != "" {
+		refreshInterval, _ := time.ParseDuration(os.Getenv("JWKS_REFRESH_INTERVAL"))
+		authClient, err = NewAuthClientWithConfig(AuthConfig{
+			JWKSURL:         jwksURL,
+			RefreshInterval: refreshInterval,
+			Issuer:          os.Getenv("JWT_ISSUER"),
+			Audience:        os.Getenv("JWT_AUDIENCE"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize JWKS auth client: %w", err)
+		}
+	} else {
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			log.Println("⚠️  JWT_SECRET not set, using default (INSECURE for production)")
+			jwtSecret = "default-secret-change-in-production"
+		}
+		authClient = NewAuthClient(jwtSecret)
+	}
+
+	// jwtSigningSecret mints AppRole login tokens (see app_role.go). This is
+	// independent of authClient's own verification mode: even a deployment
+	// that verifies third-party tokens via JWKS needs a secret of its own to
+	// sign tokens for credentials it issues itself, since it holds no JWKS
+	// provider's private key.
+	jwtSigningSecret := os.Getenv("JWT_SECRET")
+	if jwtSigningSecret == "" {
+		jwtSigningSecret = "default-secret-change-in-production"
+	}
+
+	// appRoleHMACKey hashes every SecretID this service issues; falls back
+	// to jwtSigningSecret if unset, so a deployment that hasn't configured
+	// APPROLE_HMAC_KEY still gets a non-empty key rather than an insecure
+	// all-zero one.
+	appRoleHMACKey := os.Getenv("APPROLE_HMAC_KEY")
+	if appRoleHMACKey == "" {
+		appRoleHMACKey = jwtSigningSecret
 	}
-	authClient := NewAuthClient(jwtSecret)
 
 	// Initialize audit logger
 	auditLogPath := os.Getenv("AUDIT_LOG_PATH")
 	if auditLogPath == "" {
 		auditLogPath = "audit.log"
 	}
-	auditLogger, err := NewAuditLogger(auditLogPath)
+	auditLogger, err := NewAuditLogger(AuditConfig{FilePath: auditLogPath})
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize audit logger: %w", err)
 	}
@@ -53,22 +124,90 @@ func NewControlPlaneV1() (*ControlPlaneV1, error) {
 		SetTimeout(10 * time.Second).
 		SetBaseURL(CoreServiceURL)
 
+	// Initialize metrics
+	metrics := NewMetrics()
+
 	// Set up Gin router
 	if os.Getenv("GIN_MODE") == "release" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 	router := gin.New()
-	router.Use(gin.Recovery())
+	router.Use(RecoveryMiddlewareV1(metrics, auditLogger))
+
+	// Initialize peer gossip: notifies other control-plane replicas when a
+	// mutation here might leave their caches stale.
+	peerNotifier := cluster.NewPeerNotifierFromEnv(metrics)
+	peerTrustedKeys := cluster.TrustedKeysFromEnv()
+
+	// Initialize per-tenant rate limiting. QUOTA_CONFIG_PATH may point at a
+	// quotas.yaml overriding specific tenants; a missing or malformed file
+	// is logged and skipped rather than failing startup, so every tenant
+	// simply runs under quotas.DefaultLimits() until one is configured.
+	quotaLimiter := quotas.NewLimiter(quotas.DefaultLimits(), metrics)
+	if quotaConfigPath := os.Getenv("QUOTA_CONFIG_PATH"); quotaConfigPath != "" {
+		tenantQuotas, err := quotas.LoadConfigFile(quotaConfigPath)
+		if err != nil {
+			log.Printf("quota limiter: %v, using defaults for every tenant", err)
+		} else {
+			for tenantID, q := range tenantQuotas {
+				quotaLimiter.SetTenantQuotas(tenantID, q)
+			}
+		}
+	}
 
-	// Initialize metrics
-	metrics := NewMetrics()
+	// Initialize OpenTelemetry tracing, if OTEL_EXPORTER_OTLP_ENDPOINT is set
+	otlpEndpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	tracingShutdown, err := InitOTLPTracing(otlpEndpoint, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+
+	// Initialize the queryable audit repository backing GET /api/v1/audit,
+	// selected by AUDIT_BACKEND. This is separate from auditLogger above,
+	// which only fans events out to write-only sinks (file, syslog,
+	// Kafka, ...); AuditRepository additionally supports the FindByUser/
+	// FindByTenant/FindByTimeRange/FindErrors queries that endpoint needs.
+	auditRepo, auditRepoClose, err := persistence.NewAuditRepositoryFromEnv(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize audit repository: %w", err)
+	}
+
+	// Inject the current span's trace context into every outgoing request
+	// to core, so proxyToCoreAuth/proxyToCoreAuthWithBody propagate it.
+	client.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		carrier := &mapCarrier{m: make(map[string]string)}
+		otel.GetTextMapPropagator().Inject(req.Context(), carrier)
+		for k, v := range carrier.m {
+			req.SetHeader(k, v)
+		}
+		return nil
+	})
+
+	appRoleRepo := persistence.NewMemoryAppRoleRepository(0)
 
 	cp := &ControlPlaneV1{
-		client:      client,
-		router:      router,
-		metrics:     metrics,
-		authClient:  authClient,
-		auditLogger: auditLogger,
+		client:             client,
+		router:             router,
+		metrics:            metrics,
+		authClient:         authClient,
+		auditLogger:        auditLogger,
+		peerNotifier:       peerNotifier,
+		peerTrustedKeys:    peerTrustedKeys,
+		accessRequestRepo:  persistence.NewMemoryAccessRequestRepository(),
+		approvalThreshold:  approvalThreshold(),
+		stopReaper:         make(chan struct{}),
+		tracingEnabled:     otlpEndpoint != "",
+		tracingShutdown:    tracingShutdown,
+		quotaLimiter:       quotaLimiter,
+		auditRepo:          auditRepo,
+		auditRepoClose:     auditRepoClose,
+		appRoleRepo:        appRoleRepo,
+		jwtSigningSecret:   []byte(jwtSigningSecret),
+		createAppRoleUC:    usecases.NewCreateAppRoleUseCase(appRoleRepo),
+		rotateRoleIDUC:     usecases.NewRotateRoleIDUseCase(appRoleRepo),
+		generateSecretIDUC: usecases.NewGenerateSecretIDUseCase(appRoleRepo, []byte(appRoleHMACKey)),
+		loginWithAppRoleUC: usecases.NewLoginWithAppRoleUseCase(appRoleRepo, []byte(appRoleHMACKey)),
+		auditQueryUC:       usecases.NewAuditQueryUseCase(auditRepo),
 	}
 
 	// Setup middleware
@@ -77,9 +216,26 @@ func NewControlPlaneV1() (*ControlPlaneV1, error) {
 	// Setup routes
 	cp.setupRoutes()
 
+	// Reap access requests that nobody approved or denied before they expired
+	go cp.reapExpiredAccessRequests()
+
 	return cp, nil
 }
 
+// approvalThreshold reads APPROVAL_THRESHOLD from the environment, falling
+// back to DefaultApprovalThreshold if unset or invalid.
+func approvalThreshold() int {
+	raw := os.Getenv("APPROVAL_THRESHOLD")
+	if raw == "" {
+		return DefaultApprovalThreshold
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		return DefaultApprovalThreshold
+	}
+	return n
+}
+
 func (cp *ControlPlaneV1) setupMiddleware() {
 	// CORS middleware
 	cp.router.Use(func(c *gin.Context) {
@@ -103,6 +259,13 @@ func (cp *ControlPlaneV1) setupMiddleware() {
 
 	// Auth middleware (applied globally, but allows health/metrics endpoints)
 	cp.router.Use(AuthMiddleware(cp.authClient))
+
+	// Per-tenant rate limiting (after auth, since it keys off the
+	// authenticated tenant)
+	cp.router.Use(QuotaMiddleware(cp.quotaLimiter, cp.auditLogger))
+
+	// Tracing middleware (after auth, so spans can tag the authenticated principal)
+	cp.router.Use(TracingMiddleware(serviceName))
 }
 
 func (cp *ControlPlaneV1) setupRoutes() {
@@ -110,12 +273,18 @@ func (cp *ControlPlaneV1) setupRoutes() {
 	cp.router.GET("/health", cp.healthHandler)
 	cp.router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
+	// Peer gossip receiver: authenticates via the event's own Ed25519
+	// signature (see cluster.Verify) rather than a JWT, so it's registered
+	// outside AuthMiddleware's JWT path and skipped by it explicitly.
+	cp.router.POST(cluster.NotifyPath, cp.notifyHandler)
+
 	// Authentication endpoints (public for login/register)
 	auth := cp.router.Group("/api/v1/auth")
 	{
 		auth.POST("/login", cp.loginHandler)
 		auth.POST("/register", cp.registerHandler)
 		auth.GET("/me", cp.meHandler) // Requires auth (handled by middleware)
+		auth.POST("/approle/login", cp.appRoleLoginHandler)
 	}
 
 	// Protected API endpoints
@@ -128,12 +297,35 @@ func (cp *ControlPlaneV1) setupRoutes() {
 		// Core service health check
 		api.GET("/health/core", cp.coreHealthHandler)
 
-		// Operations (require specific permissions)
+		// Audit query: non-admins are restricted to their own tenant (see
+		// queryAuditHandler).
+		api.GET("/audit", cp.queryAuditHandler)
+
+		// Audit query, combined-filter/cursor-paginated form (see
+		// queryAuditEventsHandler). Same tenant restriction as /audit.
+		api.GET("/audit/events", cp.queryAuditEventsHandler)
+
+		// Access requests: non-admins request approval to run a privileged
+		// operation; admins approve or deny it.
+		requests := api.Group("/requests")
+		{
+			requests.POST("", cp.createAccessRequestHandler)
+			requests.GET("", cp.listAccessRequestsHandler)
+			requests.POST("/:id/approve", RequireAdmin(), cp.approveAccessRequestHandler)
+			requests.POST("/:id/deny", RequireAdmin(), cp.denyAccessRequestHandler)
+		}
+
+		// Operations: an admin runs these directly; anyone else must first
+		// get an access request approved (see RequireApprovedRequest). Each
+		// is also capped on concurrent in-flight requests per tenant, since
+		// the rate limiter alone doesn't bound how long a request stays
+		// in flight.
 		operations := api.Group("/operations")
+		operations.Use(OperationsConcurrencyMiddleware(cp.quotaLimiter, cp.auditLogger))
 		{
-			operations.POST("/snapshot", RequirePermission(PermissionAdmin), cp.snapshotHandler)
-			operations.POST("/replay", RequirePermission(PermissionAdmin), cp.replayHandler)
-			operations.POST("/backup", RequirePermission(PermissionAdmin), cp.backupHandler)
+			operations.POST("/snapshot", cp.RequireApprovedRequest("snapshot"), cp.snapshotHandler)
+			operations.POST("/replay", cp.RequireApprovedRequest("replay"), cp.replayHandler)
+			operations.POST("/backup", cp.RequireApprovedRequest("backup"), cp.backupHandler)
 		}
 
 		// Tenant management (admin only)
@@ -145,6 +337,8 @@ func (cp *ControlPlaneV1) setupRoutes() {
 			tenants.POST("", cp.createTenantHandler)
 			tenants.PUT("/:id", cp.updateTenantHandler)
 			tenants.DELETE("/:id", cp.deleteTenantHandler)
+			tenants.GET("/:id/quotas", cp.getTenantQuotasHandler)
+			tenants.PUT("/:id/quotas", cp.updateTenantQuotasHandler)
 		}
 
 		// User management (admin only)
@@ -154,6 +348,16 @@ func (cp *ControlPlaneV1) setupRoutes() {
 			users.GET("", cp.listUsersHandler)
 			users.DELETE("/:id", cp.deleteUserHandler)
 		}
+
+		// AppRole management (admin only): service credentials machine
+		// clients authenticate with via POST /api/v1/auth/approle/login.
+		appRoles := api.Group("/approles")
+		appRoles.Use(RequireAdmin())
+		{
+			appRoles.POST("", cp.createAppRoleHandler)
+			appRoles.POST("/:id/rotate", cp.rotateAppRoleIDHandler)
+			appRoles.POST("/:id/secret-id", cp.generateSecretIDHandler)
+		}
 	}
 }
 
@@ -166,9 +370,9 @@ func (cp *ControlPlaneV1) healthHandler(c *gin.Context) {
 		"timestamp": time.Now().UTC(),
 		"features": gin.H{
 			"authentication": true,
-			"audit_logging":  cp.auditLogger.enabled,
+			"audit_logging":  cp.auditLogger.Enabled(),
 			"rbac":           true,
-			"tracing":        false, // Will be true when OpenTelemetry is fully integrated
+			"tracing":        cp.tracingEnabled,
 		},
 	})
 }
@@ -317,10 +521,10 @@ func (cp *ControlPlaneV1) replayHandler(c *gin.Context) {
 	cp.auditLogger.LogOperationEvent("replay", req.EntityID, auth.UserID, "initiated")
 
 	c.JSON(http.StatusOK, gin.H{
-		"status":     "replay_initiated",
-		"entity_id":  req.EntityID,
-		"as_of":      req.AsOf,
-		"timestamp":  time.Now().UTC(),
+		"status":       "replay_initiated",
+		"entity_id":    req.EntityID,
+		"as_of":        req.AsOf,
+		"timestamp":    time.Now().UTC(),
 		"initiated_by": auth.Username,
 	})
 }
@@ -350,6 +554,222 @@ func (cp *ControlPlaneV1) backupHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// RequireApprovedRequest gates a privileged operation route behind the
+// access-request workflow. An admin caller runs the operation directly,
+// same as before this workflow existed. Anyone else must supply an
+// already-APPROVED request's ID via the X-Access-Request-ID header; if
+// it's missing, this middleware creates a new PENDING one and responds
+// 202 with its ID so the caller can poll GET /api/v1/requests, and if it's
+// present but not yet APPROVED, it responds 202 again rather than erroring.
+func (cp *ControlPlaneV1) RequireApprovedRequest(operation string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth, err := GetAuthContext(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized", "message": "authentication required"})
+			c.Abort()
+			return
+		}
+
+		if RoleHasPermission(auth.Role, entities.PermissionAdmin) {
+			c.Next()
+			return
+		}
+
+		requestID := c.GetHeader("X-Access-Request-ID")
+		if requestID == "" {
+			req, err := cp.createAccessRequest(auth.UserID, operation, nil)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "failed to create access request", "message": err.Error()})
+				c.Abort()
+				return
+			}
+			c.JSON(http.StatusAccepted, gin.H{
+				"status":     "pending_approval",
+				"request_id": req.ID,
+				"message":    "operation requires admin approval; retry with header X-Access-Request-ID once approved",
+			})
+			c.Abort()
+			return
+		}
+
+		req, err := cp.accessRequestRepo.FindByID(requestID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "access request not found"})
+			c.Abort()
+			return
+		}
+		if req.Operation != operation || req.Requester != auth.UserID {
+			c.JSON(http.StatusForbidden, gin.H{"error": "access request does not match this operation and requester"})
+			c.Abort()
+			return
+		}
+		if req.State != entities.AccessRequestApproved {
+			c.JSON(http.StatusAccepted, gin.H{"status": "pending_approval", "request_id": req.ID, "state": req.State})
+			c.Abort()
+			return
+		}
+
+		if err := req.MarkApplied(); err != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+		if err := cp.accessRequestRepo.Update(req); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+		cp.auditLogger.LogOperationEvent(operation, req.ID, auth.UserID, "access request applied")
+
+		c.Next()
+	}
+}
+
+// createAccessRequest builds and persists a new PENDING AccessRequest,
+// logging its creation.
+func (cp *ControlPlaneV1) createAccessRequest(requester, operation string, params map[string]interface{}) (*entities.AccessRequest, error) {
+	req, err := entities.NewAccessRequest(requester, operation, params, AccessRequestTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := cp.accessRequestRepo.Save(req); err != nil {
+		return nil, err
+	}
+	cp.auditLogger.LogOperationEvent(operation, req.ID, requester, "access request created")
+	return req, nil
+}
+
+// createAccessRequestBody is the request body for POST /api/v1/requests.
+type createAccessRequestBody struct {
+	Operation string                 `json:"operation" binding:"required"`
+	Params    map[string]interface{} `json:"params"`
+}
+
+func (cp *ControlPlaneV1) createAccessRequestHandler(c *gin.Context) {
+	auth, _ := GetAuthContext(c)
+
+	var body createAccessRequestBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request", "message": err.Error()})
+		return
+	}
+
+	req, err := cp.createAccessRequest(auth.UserID, body.Operation, body.Params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, accessRequestToJSON(req))
+}
+
+func (cp *ControlPlaneV1) listAccessRequestsHandler(c *gin.Context) {
+	reqs, err := cp.accessRequestRepo.FindAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	out := make([]gin.H, 0, len(reqs))
+	for _, req := range reqs {
+		out = append(out, accessRequestToJSON(req))
+	}
+	c.JSON(http.StatusOK, gin.H{"requests": out})
+}
+
+func (cp *ControlPlaneV1) approveAccessRequestHandler(c *gin.Context) {
+	auth, _ := GetAuthContext(c)
+
+	req, err := cp.accessRequestRepo.FindByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "access request not found"})
+		return
+	}
+
+	if err := req.Approve(auth.UserID, cp.approvalThreshold); err != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err := cp.accessRequestRepo.Update(req); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	cp.auditLogger.LogOperationEvent(req.Operation, req.ID, auth.UserID, fmt.Sprintf("approved (state=%s)", req.State))
+	c.JSON(http.StatusOK, accessRequestToJSON(req))
+}
+
+func (cp *ControlPlaneV1) denyAccessRequestHandler(c *gin.Context) {
+	auth, _ := GetAuthContext(c)
+
+	req, err := cp.accessRequestRepo.FindByID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "access request not found"})
+		return
+	}
+
+	if err := req.Deny(); err != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err := cp.accessRequestRepo.Update(req); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	cp.auditLogger.LogOperationEvent(req.Operation, req.ID, auth.UserID, "denied")
+	c.JSON(http.StatusOK, accessRequestToJSON(req))
+}
+
+func accessRequestToJSON(req *entities.AccessRequest) gin.H {
+	return gin.H{
+		"id":         req.ID,
+		"requester":  req.Requester,
+		"operation":  req.Operation,
+		"params":     req.Params,
+		"state":      req.State,
+		"approvers":  req.Approvers,
+		"created_at": req.CreatedAt,
+		"expires_at": req.ExpiresAt,
+	}
+}
+
+// reapExpiredAccessRequests periodically marks PENDING access requests
+// EXPIRED once they've sat unapproved past their ExpiresAt, until
+// stopReaper is closed.
+func (cp *ControlPlaneV1) reapExpiredAccessRequests() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cp.stopReaper:
+			return
+		case <-ticker.C:
+			pending, err := cp.accessRequestRepo.FindPending()
+			if err != nil {
+				log.Printf("access request reaper: %v", err)
+				continue
+			}
+
+			now := time.Now()
+			for _, req := range pending {
+				if !req.IsExpired(now) {
+					continue
+				}
+				if err := req.MarkExpired(); err != nil {
+					continue
+				}
+				if err := cp.accessRequestRepo.Update(req); err != nil {
+					log.Printf("access request reaper: update %s: %v", req.ID, err)
+					continue
+				}
+				cp.auditLogger.LogOperationEvent(req.Operation, req.ID, req.Requester, "expired")
+			}
+		}
+	}
+}
+
 // Tenant handlers (proxied to core)
 func (cp *ControlPlaneV1) listTenantsHandler(c *gin.Context) {
 	cp.proxyToCoreAuth(c, "GET", "/api/v1/tenants")
@@ -392,6 +812,7 @@ func (cp *ControlPlaneV1) updateTenantHandler(c *gin.Context) {
 	}
 
 	cp.auditLogger.LogTenantEvent("update", tenantID, auth.UserID, "tenant updated")
+	cp.notifyPeers(cluster.EventTenantUpdated, tenantID)
 
 	var result map[string]interface{}
 	resp.UnmarshalJson(&result)
@@ -408,6 +829,7 @@ func (cp *ControlPlaneV1) deleteTenantHandler(c *gin.Context) {
 	}
 
 	cp.auditLogger.LogTenantEvent("delete", tenantID, auth.UserID, "tenant deleted")
+	cp.notifyPeers(cluster.EventTenantDeleted, tenantID)
 	c.JSON(resp.StatusCode(), gin.H{"message": "tenant deleted"})
 }
 
@@ -433,14 +855,61 @@ func (cp *ControlPlaneV1) deleteUserHandler(c *gin.Context) {
 	}
 
 	cp.auditLogger.LogAuthEvent("user_delete", userID, "", "", "user deleted by "+auth.Username)
+	cp.notifyPeers(cluster.EventUserDeleted, userID)
 	c.JSON(resp.StatusCode(), gin.H{"message": "user deleted"})
 }
 
+// notifyPeers fans a gossip event out to every peer control plane and logs
+// any peer that didn't acknowledge it, without failing the request that
+// triggered it.
+func (cp *ControlPlaneV1) notifyPeers(eventType, targetID string) {
+	for _, peerErr := range cp.peerNotifier.Notify(eventType, targetID) {
+		log.Printf("peer notify: %s", peerErr.Error())
+	}
+}
+
+// notifyHandler handles POST /internal/v1/notify: a peer control plane
+// informs this one that a mutation may have left a locally cached copy of
+// a user, tenant, or policy stale. Today AuthClient holds no cache of its
+// own (it verifies JWTs statelessly against KeyProvider on every request),
+// so there is nothing here yet to invalidate; this receiver still logs
+// every event, verifying its signature when peerTrustedKeys is non-empty
+// and accepting it unverified otherwise, so the gossip protocol and its
+// signing keys are exercised end-to-end, and so a future cache only has
+// to plug into the switch below.
+func (cp *ControlPlaneV1) notifyHandler(c *gin.Context) {
+	var event cluster.Event
+	if err := c.ShouldBindJSON(&event); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid notify event", "message": err.Error()})
+		return
+	}
+
+	if len(cp.peerTrustedKeys) > 0 {
+		verified, err := cluster.Verify(event, cp.peerTrustedKeys)
+		if err != nil || !verified {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "notify event failed signature verification"})
+			return
+		}
+	}
+
+	switch event.Type {
+	case cluster.EventUserDeleted, cluster.EventUserUpdated, cluster.EventTenantDeleted, cluster.EventTenantUpdated, cluster.EventPolicyReloaded:
+		// No-op: nothing in this process caches users, tenants, or policies
+		// today. Logged below so the event is still observable.
+	default:
+		log.Printf("peer notify: ignoring unknown event type %q from peer", event.Type)
+	}
+
+	cp.auditLogger.LogAuthEvent("peer_notify", event.TargetID, "", "", "received "+event.Type+" from peer")
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
 // Helper: proxy request to core with auth
 func (cp *ControlPlaneV1) proxyToCoreAuth(c *gin.Context, method, path string) {
 	token, _ := ExtractToken(c)
 
 	resp, err := cp.client.R().
+		SetContext(c.Request.Context()).
 		SetHeader("Authorization", "Bearer "+token).
 		Execute(method, path)
 
@@ -461,6 +930,7 @@ func (cp *ControlPlaneV1) proxyToCoreAuthWithBody(c *gin.Context, method, path s
 	token, _ := ExtractToken(c)
 
 	resp, err := cp.client.R().
+		SetContext(c.Request.Context()).
 		SetHeader("Authorization", "Bearer "+token).
 		SetBody(body).
 		Execute(method, path)
@@ -480,6 +950,21 @@ func (cp *ControlPlaneV1) Start(port string) error {
 }
 
 func (cp *ControlPlaneV1) Shutdown() error {
+	close(cp.stopReaper)
+	if cp.tracingShutdown != nil {
+		if err := cp.tracingShutdown(context.Background()); err != nil {
+			log.Printf("tracing shutdown: %v", err)
+		}
+	}
+	if cp.auditRepoClose != nil {
+		cp.auditRepoClose()
+	}
+	if cp.appRoleRepo != nil {
+		cp.appRoleRepo.Close()
+	}
+	if cp.authClient != nil {
+		cp.authClient.Close()
+	}
 	if cp.auditLogger != nil {
 		return cp.auditLogger.Close()
 	}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"strconv"
 	"time"
 
+	"github.com/allsource/control-plane/internal/quotas"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -16,6 +18,13 @@ type ControlPlaneMetrics struct {
 	ReplayOperationsTotal   prometheus.Counter
 	HTTPRequestsTotal       *prometheus.CounterVec
 	HTTPRequestDuration     *prometheus.HistogramVec
+	PanicsTotal             *prometheus.CounterVec
+	PeerNotifyTotal         *prometheus.CounterVec
+	TraceExportErrorsTotal  prometheus.Counter
+	PanicsRecoveredTotal    prometheus.Counter
+	QuotaRejectedTotal      *prometheus.CounterVec
+	QuotaTokens             *prometheus.GaugeVec
+	AuditQueryDuration      prometheus.Histogram
 }
 
 // NewMetrics creates and registers all Prometheus metrics
@@ -41,15 +50,62 @@ func NewMetrics() *ControlPlaneMetrics {
 		HTTPRequestsTotal: promauto.NewCounterVec(prometheus.CounterOpts{
 			Name: "control_plane_http_requests_total",
 			Help: "Total number of HTTP requests",
-		}, []string{"method", "path", "status"}),
+		}, []string{"method", "path", "status", "status_class", "tenant_id"}),
 		HTTPRequestDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
 			Name:    "control_plane_http_request_duration_seconds",
 			Help:    "Duration of HTTP requests",
 			Buckets: prometheus.DefBuckets,
 		}, []string{"method", "path"}),
+		PanicsTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "control_plane_panics_total",
+			Help: "Total number of panics recovered from HTTP handlers",
+		}, []string{"route", "method"}),
+		PeerNotifyTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "control_plane_peer_notify_total",
+			Help: "Total number of gossip cache-invalidation notifications sent to peer control planes",
+		}, []string{"event", "status"}),
+		TraceExportErrorsTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "control_plane_trace_export_errors_total",
+			Help: "Total number of failed OTLP trace export batches",
+		}),
+		PanicsRecoveredTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "control_plane_panics_recovered_total",
+			Help: "Total number of panics recovered by RecoveryMiddlewareV1",
+		}),
+		QuotaRejectedTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "control_plane_quota_rejected_total",
+			Help: "Total number of requests rejected by a tenant's rate limit",
+		}, []string{"tenant", "group"}),
+		QuotaTokens: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "control_plane_quota_tokens",
+			Help: "Tokens remaining in a tenant's rate-limit bucket",
+		}, []string{"tenant", "group"}),
+		AuditQueryDuration: promauto.NewHistogram(prometheus.HistogramOpts{
+			Name:    "control_plane_audit_query_duration_seconds",
+			Help:    "Duration of GET /api/v1/audit queries against the audit repository",
+			Buckets: prometheus.DefBuckets,
+		}),
 	}
 }
 
+// RecordPeerNotify implements cluster.Recorder, so a PeerNotifier can
+// report notify outcomes without this metrics package depending on
+// internal/cluster.
+func (m *ControlPlaneMetrics) RecordPeerNotify(event, status string) {
+	m.PeerNotifyTotal.WithLabelValues(event, status).Inc()
+}
+
+// RecordQuotaRejected implements quotas.Recorder, so a Limiter can report
+// rejections without this metrics package depending on internal/quotas.
+func (m *ControlPlaneMetrics) RecordQuotaRejected(tenantID string, group quotas.RouteGroup) {
+	m.QuotaRejectedTotal.WithLabelValues(tenantID, string(group)).Inc()
+}
+
+// RecordQuotaTokens implements quotas.Recorder.
+func (m *ControlPlaneMetrics) RecordQuotaTokens(tenantID string, group quotas.RouteGroup, tokens float64) {
+	m.QuotaTokens.WithLabelValues(tenantID, string(group)).Set(tokens)
+}
+
 // PrometheusMiddleware creates a Gin middleware for recording HTTP metrics
 func PrometheusMiddleware(metrics *ControlPlaneMetrics) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -65,6 +121,15 @@ func PrometheusMiddleware(metrics *ControlPlaneMetrics) gin.HandlerFunc {
 			path = c.Request.URL.Path
 		}
 
+		status := c.Writer.Status()
+
+		var tenantID string
+		if auth, exists := c.Get("auth"); exists {
+			if authCtx, ok := auth.(*AuthContext); ok {
+				tenantID = authCtx.TenantID
+			}
+		}
+
 		metrics.HTTPRequestDuration.WithLabelValues(
 			c.Request.Method,
 			path,
@@ -73,7 +138,27 @@ func PrometheusMiddleware(metrics *ControlPlaneMetrics) gin.HandlerFunc {
 		metrics.HTTPRequestsTotal.WithLabelValues(
 			c.Request.Method,
 			path,
-			string(rune(c.Writer.Status())),
+			strconv.Itoa(status),
+			statusClass(status),
+			tenantID,
 		).Inc()
 	}
 }
+
+// statusClass buckets an HTTP status code into its class (e.g. 200 ->
+// "2xx"), so operators can compute per-tenant error-budget burn without
+// enumerating every status code.
+func statusClass(status int) string {
+	switch {
+	case status >= 500:
+		return "5xx"
+	case status >= 400:
+		return "4xx"
+	case status >= 300:
+		return "3xx"
+	case status >= 200:
+		return "2xx"
+	default:
+		return "1xx"
+	}
+}
@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"net"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -15,6 +18,13 @@ const (
 	ActionAllow PolicyAction = "allow"
 	ActionDeny  PolicyAction = "deny"
 	ActionWarn  PolicyAction = "warn"
+
+	// ActionIndeterminate marks a PolicyResult where a matched policy's
+	// condition referenced an attribute ctx didn't provide, so Evaluate
+	// couldn't determine whether the policy applied. Combining algorithms
+	// that always produce a definite answer (CombineDenyUnlessPermit,
+	// CombinePermitUnlessDeny) never return this.
+	ActionIndeterminate PolicyAction = "indeterminate"
 )
 
 // PolicyCondition represents a condition for a policy
@@ -34,18 +44,64 @@ type Policy struct {
 	Conditions  []PolicyCondition `json:"conditions"`
 	Priority    int               `json:"priority"` // Higher priority = evaluated first
 	Enabled     bool              `json:"enabled"`
+
+	// Expression is an optional compound boolean expression, evaluated by
+	// ExpressionLanguage, that is ANDed together with Conditions. This lets
+	// policies express logic Conditions alone can't, e.g.
+	// `ctx.role == "Admin" && ctx.attributes.record_count < 10000`.
+	Expression         string             `json:"expression,omitempty"`
+	ExpressionLanguage ExpressionLanguage `json:"expression_language,omitempty"`
+
+	// ConditionTree is an optional boolean expression tree (see
+	// PolicyExpression) ANDed together with Conditions and Expression. Unlike
+	// Conditions, which can only express an AND of leaves, ConditionTree can
+	// combine conditions with AND/OR/NOT, e.g. "role == admin OR tenant_id ==
+	// default".
+	ConditionTree *PolicyExpression `json:"condition_tree,omitempty"`
+
+	// Obligations are attached to the PolicyResult whenever this policy
+	// matches, regardless of whether it's the one that decides the
+	// request (see CombiningAlgorithm and reduceDecision).
+	Obligations []Obligation `json:"obligations,omitempty"`
 }
 
 // PolicyEngine evaluates policies
 type PolicyEngine struct {
 	policies map[string]*Policy
-	mu       sync.RWMutex
+
+	// order holds the same policies as the policies map, kept sorted by
+	// Priority descending as AddPolicy/RemovePolicy mutate it. Evaluate reads
+	// straight from it instead of re-sorting on every call.
+	order []*Policy
+
+	// compiledTrees holds each policy's compiled ConditionTree, keyed by
+	// policy ID, so Evaluate never re-walks the expression tree.
+	compiledTrees map[string]compiledNode
+
+	expressions *expressionCache
+	mu          sync.RWMutex
+
+	// revision is the revision of the last SignedBundle successfully
+	// applied via LoadSignedBundle, used to reject stale bundle rollbacks.
+	// It stays 0 for engines that never load a signed bundle.
+	revision uint64
+
+	// combiningAlgorithms holds a per-Resource CombiningAlgorithm override,
+	// set via SetCombiningAlgorithm. Resources without an entry here use
+	// defaultCombiningAlgorithm.
+	combiningAlgorithms map[string]CombiningAlgorithm
+
+	// defaultCombiningAlgorithm is used for any resource without an entry
+	// in combiningAlgorithms. Empty falls back to DefaultCombiningAlgorithm.
+	defaultCombiningAlgorithm CombiningAlgorithm
 }
 
 // NewPolicyEngine creates a new policy engine
 func NewPolicyEngine() *PolicyEngine {
 	pe := &PolicyEngine{
-		policies: make(map[string]*Policy),
+		policies:      make(map[string]*Policy),
+		compiledTrees: make(map[string]compiledNode),
+		expressions:   newExpressionCache(),
 	}
 
 	// Add default policies
@@ -167,12 +223,77 @@ func (pe *PolicyEngine) addDefaultPolicies() {
 	})
 }
 
-// AddPolicy adds a policy to the engine
-func (pe *PolicyEngine) AddPolicy(policy *Policy) {
+// AddPolicy adds a policy to the engine. If the policy carries an
+// Expression or a ConditionTree, it is compiled immediately so evaluation
+// never pays a first-call parsing cost, or repeats one, on the request path.
+func (pe *PolicyEngine) AddPolicy(policy *Policy) error {
+	if policy.Expression != "" {
+		lang := policy.ExpressionLanguage
+		if lang == "" {
+			lang = ExpressionLanguageCEL
+		}
+		evaluator, ok := conditionEvaluators[lang]
+		if !ok {
+			return fmt.Errorf("no evaluator registered for expression language %q", lang)
+		}
+		compiled, err := evaluator.Compile(policy.Expression)
+		if err != nil {
+			return fmt.Errorf("policy %q: %w", policy.ID, err)
+		}
+		pe.expressions.set(policy.ID, compiled)
+	}
+
+	var compiledTree compiledNode
+	if policy.ConditionTree != nil {
+		compiled, err := compilePolicyExpression(policy.ConditionTree)
+		if err != nil {
+			return fmt.Errorf("policy %q: %w", policy.ID, err)
+		}
+		compiledTree = compiled
+	}
+
 	pe.mu.Lock()
 	defer pe.mu.Unlock()
 
+	if _, exists := pe.policies[policy.ID]; exists {
+		pe.removeFromOrderLocked(policy.ID)
+	}
 	pe.policies[policy.ID] = policy
+	pe.insertOrderedLocked(policy)
+
+	if compiledTree != nil {
+		if pe.compiledTrees == nil {
+			pe.compiledTrees = make(map[string]compiledNode)
+		}
+		pe.compiledTrees[policy.ID] = compiledTree
+	} else {
+		delete(pe.compiledTrees, policy.ID)
+	}
+
+	return nil
+}
+
+// insertOrderedLocked inserts policy into pe.order, keeping it sorted by
+// Priority descending via a binary search for the insertion point. Callers
+// must hold pe.mu.
+func (pe *PolicyEngine) insertOrderedLocked(policy *Policy) {
+	idx := sort.Search(len(pe.order), func(i int) bool {
+		return pe.order[i].Priority < policy.Priority
+	})
+	pe.order = append(pe.order, nil)
+	copy(pe.order[idx+1:], pe.order[idx:])
+	pe.order[idx] = policy
+}
+
+// removeFromOrderLocked removes policyID's entry from pe.order, if present.
+// Callers must hold pe.mu.
+func (pe *PolicyEngine) removeFromOrderLocked(policyID string) {
+	for i, p := range pe.order {
+		if p.ID == policyID {
+			pe.order = append(pe.order[:i], pe.order[i+1:]...)
+			return
+		}
+	}
 }
 
 // RemovePolicy removes a policy from the engine
@@ -181,6 +302,9 @@ func (pe *PolicyEngine) RemovePolicy(policyID string) {
 	defer pe.mu.Unlock()
 
 	delete(pe.policies, policyID)
+	delete(pe.compiledTrees, policyID)
+	pe.expressions.delete(policyID)
+	pe.removeFromOrderLocked(policyID)
 }
 
 // GetPolicy retrieves a policy by ID
@@ -212,100 +336,82 @@ type PolicyContext struct {
 	UserID     string
 	TenantID   string
 	Role       Role
+	SourceIP   string
 	Attributes map[string]interface{}
 }
 
 // PolicyResult represents the result of policy evaluation
 type PolicyResult struct {
-	Allowed  bool
-	Action   PolicyAction
-	PolicyID string
-	Message  string
+	Allowed     bool
+	Action      PolicyAction
+	PolicyID    string
+	Message     string
+	Obligations []Obligation
 }
 
-// Evaluate evaluates all policies against the given context
+// Evaluate evaluates all policies applicable to ctx.Resource, collects
+// every one that matches, and reduces them to a single decision via the
+// resource's CombiningAlgorithm (DefaultCombiningAlgorithm unless
+// SetCombiningAlgorithm was called for ctx.Resource). Obligations from
+// every matched policy are returned regardless of which one decided the
+// outcome. If a matched policy's condition referenced an attribute ctx
+// didn't provide, and the combining algorithm doesn't resolve that away
+// (CombineDenyUnlessPermit/CombinePermitUnlessDeny always do), the result
+// is ActionIndeterminate rather than a silent deny.
 func (pe *PolicyEngine) Evaluate(ctx PolicyContext) PolicyResult {
 	pe.mu.RLock()
 	defer pe.mu.RUnlock()
 
-	// Sort policies by priority (higher first)
-	var applicablePolicies []*Policy
-	for _, policy := range pe.policies {
-		if policy.Enabled && policy.Resource == ctx.Resource {
-			applicablePolicies = append(applicablePolicies, policy)
+	algo := pe.combiningAlgorithmLocked(ctx.Resource)
+
+	var denies, permits []*Policy
+	var obligations []Obligation
+	indeterminate := false
+
+	// pe.order is already sorted by priority (higher first); AddPolicy and
+	// RemovePolicy keep it that way, so there's nothing to sort here.
+	for _, policy := range pe.order {
+		if !policy.Enabled || policy.Resource != ctx.Resource {
+			continue
 		}
-	}
 
-	// Sort by priority
-	for i := 0; i < len(applicablePolicies); i++ {
-		for j := i + 1; j < len(applicablePolicies); j++ {
-			if applicablePolicies[j].Priority > applicablePolicies[i].Priority {
-				applicablePolicies[i], applicablePolicies[j] = applicablePolicies[j], applicablePolicies[i]
-			}
+		conditionsMatched, conditionsIndeterminate := pe.evaluateConditionsTri(policy.Conditions, ctx)
+		if conditionsIndeterminate {
+			indeterminate = true
+			continue
+		}
+		if !conditionsMatched || !pe.evaluatePolicyExpression(policy, ctx) || !pe.evaluateConditionTree(policy, ctx) {
+			continue
 		}
-	}
 
-	// Evaluate policies in priority order
-	for _, policy := range applicablePolicies {
-		if pe.evaluateConditions(policy.Conditions, ctx) {
-			// Policy matched
-			if policy.Action == ActionDeny {
-				return PolicyResult{
-					Allowed:  false,
-					Action:   ActionDeny,
-					PolicyID: policy.ID,
-					Message:  policy.Description,
-				}
-			} else if policy.Action == ActionWarn {
-				// Log warning but continue
-				return PolicyResult{
-					Allowed:  true,
-					Action:   ActionWarn,
-					PolicyID: policy.ID,
-					Message:  policy.Description,
-				}
-			}
+		for _, obligation := range policy.Obligations {
+			obligation.PolicyID = policy.ID
+			obligations = append(obligations, obligation)
 		}
-	}
 
-	// No denying policy matched, allow by default
-	return PolicyResult{
-		Allowed: true,
-		Action:  ActionAllow,
-		Message: "No policy matched, default allow",
-	}
-}
+		switch policy.Action {
+		case ActionDeny:
+			denies = append(denies, policy)
+		default:
+			permits = append(permits, policy)
+		}
 
-// evaluateConditions checks if all conditions match
-func (pe *PolicyEngine) evaluateConditions(conditions []PolicyCondition, ctx PolicyContext) bool {
-	for _, condition := range conditions {
-		if !pe.evaluateCondition(condition, ctx) {
-			return false
+		if algo == CombineFirstApplicable {
+			break
 		}
 	}
-	return true
+
+	return reduceDecision(algo, denies, permits, obligations, indeterminate)
 }
 
-// evaluateCondition checks if a single condition matches
+// evaluateCondition checks if a single condition matches. Operators beyond
+// plain equality (gte, lte, between, regex, cidr) share their field lookup,
+// variable resolution, and numeric coercion with the compiled ConditionTree
+// path in policy_ast.go.
 func (pe *PolicyEngine) evaluateCondition(condition PolicyCondition, ctx PolicyContext) bool {
-	// Get the field value from context
-	var fieldValue interface{}
-	switch condition.Field {
-	case "operation":
-		fieldValue = ctx.Operation
-	case "user_id":
-		fieldValue = ctx.UserID
-	case "tenant_id":
-		fieldValue = ctx.TenantID
-	case "role":
-		fieldValue = string(ctx.Role)
-	default:
-		// Check in attributes
-		var ok bool
-		fieldValue, ok = ctx.Attributes[condition.Field]
-		if !ok {
-			return false
-		}
+	fieldValue, ok := contextFieldValue(condition.Field, ctx)
+	if !ok {
+		return false
 	}
 
 	// Handle special variables
@@ -313,12 +419,7 @@ func (pe *PolicyEngine) evaluateCondition(condition PolicyCondition, ctx PolicyC
 	if strVal, ok := condValue.(string); ok {
 		if strings.HasPrefix(strVal, "${") && strings.HasSuffix(strVal, "}") {
 			varName := strings.TrimSuffix(strings.TrimPrefix(strVal, "${"), "}")
-			switch varName {
-			case "user_id":
-				condValue = ctx.UserID
-			case "tenant_id":
-				condValue = ctx.TenantID
-			}
+			condValue = resolveVariable(varName, ctx)
 		}
 	}
 
@@ -329,55 +430,91 @@ func (pe *PolicyEngine) evaluateCondition(condition PolicyCondition, ctx PolicyC
 	case "ne":
 		return fmt.Sprintf("%v", fieldValue) != fmt.Sprintf("%v", condValue)
 	case "gt":
-		if fv, ok := fieldValue.(int); ok {
-			if cv, ok := condValue.(int); ok {
-				return fv > cv
-			}
-			if cv, ok := condValue.(float64); ok {
-				return fv > int(cv)
-			}
-		}
-		if fv, ok := fieldValue.(float64); ok {
-			if cv, ok := condValue.(float64); ok {
-				return fv > cv
-			}
-		}
+		fv, fok := toFloat(fieldValue)
+		cv, cok := toFloat(condValue)
+		return fok && cok && fv > cv
 	case "lt":
-		if fv, ok := fieldValue.(int); ok {
-			if cv, ok := condValue.(int); ok {
-				return fv < cv
-			}
+		fv, fok := toFloat(fieldValue)
+		cv, cok := toFloat(condValue)
+		return fok && cok && fv < cv
+	case "gte":
+		fv, fok := toFloat(fieldValue)
+		cv, cok := toFloat(condValue)
+		return fok && cok && fv >= cv
+	case "lte":
+		fv, fok := toFloat(fieldValue)
+		cv, cok := toFloat(condValue)
+		return fok && cok && fv <= cv
+	case "between":
+		bounds, ok := condValue.([]interface{})
+		if !ok || len(bounds) != 2 {
+			return false
 		}
+		fv, fok := toFloat(fieldValue)
+		low, lok := toFloat(bounds[0])
+		high, hok := toFloat(bounds[1])
+		return fok && lok && hok && fv >= low && fv <= high
 	case "contains":
 		return strings.Contains(
 			fmt.Sprintf("%v", fieldValue),
 			fmt.Sprintf("%v", condValue),
 		)
 	case "in":
-		if arr, ok := condValue.([]string); ok {
-			fvStr := fmt.Sprintf("%v", fieldValue)
-			for _, item := range arr {
-				if item == fvStr {
-					return true
-				}
-			}
-		}
-		// Also handle interface{} arrays
-		if arr, ok := condValue.([]interface{}); ok {
-			fvStr := fmt.Sprintf("%v", fieldValue)
-			for _, item := range arr {
-				if fmt.Sprintf("%v", item) == fvStr {
-					return true
-				}
-			}
+		_, ok := toStringSet(condValue)[fmt.Sprintf("%v", fieldValue)]
+		return ok
+	case "regex":
+		pattern, _ := condValue.(string)
+		re, err := regexp.Compile(pattern)
+		return err == nil && re.MatchString(fmt.Sprintf("%v", fieldValue))
+	case "cidr":
+		pattern, _ := condValue.(string)
+		_, network, err := net.ParseCIDR(pattern)
+		if err != nil {
+			return false
 		}
+		ip := net.ParseIP(fmt.Sprintf("%v", fieldValue))
+		return ip != nil && network.Contains(ip)
 	}
 
 	return false
 }
 
+// evaluateConditionTree evaluates policy's compiled ConditionTree, if any,
+// against ctx. A policy with no ConditionTree always matches here so it
+// doesn't affect the AND with Conditions and Expression in Evaluate.
+func (pe *PolicyEngine) evaluateConditionTree(policy *Policy, ctx PolicyContext) bool {
+	tree, ok := pe.compiledTrees[policy.ID]
+	if !ok {
+		return true
+	}
+	return tree(ctx)
+}
+
+// policyResultContextKey is the Gin context key PolicyMiddleware stores its
+// PolicyResult under, so handlers can read back Obligations regardless of
+// whether the matched policy allowed, warned, or denied the request.
+const policyResultContextKey = "policy_result"
+
+// PolicyResultFromContext retrieves the PolicyResult PolicyMiddleware
+// attached to c, if it ran for this request.
+func PolicyResultFromContext(c *gin.Context) (PolicyResult, bool) {
+	value, exists := c.Get(policyResultContextKey)
+	if !exists {
+		return PolicyResult{}, false
+	}
+	result, ok := value.(PolicyResult)
+	return result, ok
+}
+
+// PolicyEvaluator is satisfied by PolicyEngine and CasbinPolicyEngine, so
+// PolicyMiddleware can enforce either backend's decisions without caring
+// which one is evaluating ctx.Resource.
+type PolicyEvaluator interface {
+	Evaluate(ctx PolicyContext) PolicyResult
+}
+
 // PolicyMiddleware enforces policies on requests
-func PolicyMiddleware(policyEngine *PolicyEngine, auditLogger *AuditLogger) gin.HandlerFunc {
+func PolicyMiddleware(policyEngine PolicyEvaluator, auditLogger *AuditLogger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Skip health and metrics endpoints
 		if c.Request.URL.Path == "/health" || c.Request.URL.Path == "/metrics" {
@@ -403,6 +540,7 @@ func PolicyMiddleware(policyEngine *PolicyEngine, auditLogger *AuditLogger) gin.
 			UserID:     authCtx.UserID,
 			TenantID:   authCtx.TenantID,
 			Role:       authCtx.Role,
+			SourceIP:   c.ClientIP(),
 			Attributes: make(map[string]interface{}),
 		}
 
@@ -416,6 +554,10 @@ func PolicyMiddleware(policyEngine *PolicyEngine, auditLogger *AuditLogger) gin.
 
 		// Evaluate policies
 		result := policyEngine.Evaluate(policyCtx)
+		c.Set(policyResultContextKey, result)
+		if result.PolicyID != "" {
+			auditLogger.recordPolicyHit(result.PolicyID, result.Action)
+		}
 
 		// Handle result
 		if !result.Allowed {
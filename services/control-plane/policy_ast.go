@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PolicyExpression is a boolean expression tree attached to a Policy via
+// Policy.ConditionTree. Op is one of "and", "or", "not", or "cmp": "and" and
+// "or" combine Children, "not" negates its single child, and "cmp" is a leaf
+// comparison evaluated from the embedded PolicyCondition. This lets a policy
+// express logic the flat, implicitly-ANDed Conditions list can't, e.g.
+//
+//	{"op": "or", "children": [
+//	  {"op": "cmp", "field": "role", "operator": "eq", "value": "Admin"},
+//	  {"op": "cmp", "field": "tenant_id", "operator": "eq", "value": "default"}
+//	]}
+type PolicyExpression struct {
+	Op       string             `json:"op"`
+	Children []PolicyExpression `json:"children,omitempty"`
+	PolicyCondition
+}
+
+// compiledNode is a PolicyExpression compiled into a closure tree.
+// compilePolicyExpression walks the tree once (from AddPolicy) so Evaluate
+// never re-parses a condition's Value or re-resolves a "${...}" placeholder
+// on the request path.
+type compiledNode func(ctx PolicyContext) bool
+
+// compilePolicyExpression compiles expr into a compiledNode.
+func compilePolicyExpression(expr *PolicyExpression) (compiledNode, error) {
+	switch expr.Op {
+	case "and":
+		children, err := compileChildren(expr.Children)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx PolicyContext) bool {
+			for _, child := range children {
+				if !child(ctx) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case "or":
+		children, err := compileChildren(expr.Children)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx PolicyContext) bool {
+			for _, child := range children {
+				if child(ctx) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case "not":
+		if len(expr.Children) != 1 {
+			return nil, fmt.Errorf("policy expression: \"not\" requires exactly one child, got %d", len(expr.Children))
+		}
+		child, err := compilePolicyExpression(&expr.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx PolicyContext) bool { return !child(ctx) }, nil
+	case "cmp":
+		return compileLeaf(expr.PolicyCondition)
+	default:
+		return nil, fmt.Errorf("policy expression: unknown op %q", expr.Op)
+	}
+}
+
+func compileChildren(exprs []PolicyExpression) ([]compiledNode, error) {
+	nodes := make([]compiledNode, len(exprs))
+	for i := range exprs {
+		node, err := compilePolicyExpression(&exprs[i])
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// compiledLeaf holds a "cmp" leaf's comparison value(s) precomputed into the
+// forms each operator needs (a coerced float, a regex, a parsed CIDR, ...),
+// so evaluate only ever has to type-assert the field value pulled from the
+// live PolicyContext, not the condition's static Value.
+type compiledLeaf struct {
+	field    string
+	operator string
+
+	// varName is set instead of the fields below when the condition's Value
+	// was a "${...}" placeholder, since that side of the comparison can only
+	// be resolved from the PolicyContext at evaluation time.
+	varName string
+
+	strValue  string
+	numValue  float64
+	numOK     bool
+	lowValue  float64
+	highValue float64
+	rangeOK   bool
+	setValue  map[string]struct{}
+	regex     *regexp.Regexp
+	cidr      *net.IPNet
+}
+
+func compileLeaf(cond PolicyCondition) (compiledNode, error) {
+	leaf := &compiledLeaf{field: cond.Field, operator: cond.Operator}
+
+	if strVal, ok := cond.Value.(string); ok && strings.HasPrefix(strVal, "${") && strings.HasSuffix(strVal, "}") {
+		leaf.varName = strings.TrimSuffix(strings.TrimPrefix(strVal, "${"), "}")
+		return leaf.evaluate, nil
+	}
+
+	leaf.strValue = fmt.Sprintf("%v", cond.Value)
+	if f, ok := toFloat(cond.Value); ok {
+		leaf.numValue, leaf.numOK = f, true
+	}
+
+	switch cond.Operator {
+	case "between":
+		bounds, ok := cond.Value.([]interface{})
+		if !ok || len(bounds) != 2 {
+			return nil, fmt.Errorf("policy expression: \"between\" requires a 2-element array, got %v", cond.Value)
+		}
+		low, lowOK := toFloat(bounds[0])
+		high, highOK := toFloat(bounds[1])
+		if !lowOK || !highOK {
+			return nil, fmt.Errorf("policy expression: \"between\" bounds must be numeric, got %v", cond.Value)
+		}
+		leaf.lowValue, leaf.highValue, leaf.rangeOK = low, high, true
+	case "in":
+		leaf.setValue = toStringSet(cond.Value)
+	case "regex":
+		pattern, _ := cond.Value.(string)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("policy expression: invalid regex %q: %w", pattern, err)
+		}
+		leaf.regex = re
+	case "cidr":
+		pattern, _ := cond.Value.(string)
+		_, network, err := net.ParseCIDR(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("policy expression: invalid CIDR %q: %w", pattern, err)
+		}
+		leaf.cidr = network
+	}
+
+	return leaf.evaluate, nil
+}
+
+func (leaf *compiledLeaf) evaluate(ctx PolicyContext) bool {
+	fieldValue, ok := contextFieldValue(leaf.field, ctx)
+	if !ok {
+		return false
+	}
+
+	strValue, numValue, numOK := leaf.strValue, leaf.numValue, leaf.numOK
+	if leaf.varName != "" {
+		resolved := resolveVariable(leaf.varName, ctx)
+		strValue = fmt.Sprintf("%v", resolved)
+		numValue, numOK = toFloat(resolved)
+	}
+
+	switch leaf.operator {
+	case "eq":
+		return fmt.Sprintf("%v", fieldValue) == strValue
+	case "ne":
+		return fmt.Sprintf("%v", fieldValue) != strValue
+	case "gt":
+		fv, fok := toFloat(fieldValue)
+		return fok && numOK && fv > numValue
+	case "lt":
+		fv, fok := toFloat(fieldValue)
+		return fok && numOK && fv < numValue
+	case "gte":
+		fv, fok := toFloat(fieldValue)
+		return fok && numOK && fv >= numValue
+	case "lte":
+		fv, fok := toFloat(fieldValue)
+		return fok && numOK && fv <= numValue
+	case "between":
+		fv, fok := toFloat(fieldValue)
+		return fok && leaf.rangeOK && fv >= leaf.lowValue && fv <= leaf.highValue
+	case "contains":
+		return strings.Contains(fmt.Sprintf("%v", fieldValue), strValue)
+	case "in":
+		_, ok := leaf.setValue[fmt.Sprintf("%v", fieldValue)]
+		return ok
+	case "regex":
+		return leaf.regex != nil && leaf.regex.MatchString(fmt.Sprintf("%v", fieldValue))
+	case "cidr":
+		if leaf.cidr == nil {
+			return false
+		}
+		ip := net.ParseIP(fmt.Sprintf("%v", fieldValue))
+		return ip != nil && leaf.cidr.Contains(ip)
+	default:
+		return false
+	}
+}
+
+// contextFieldValue resolves a condition field name against a PolicyContext,
+// checking the well-known fields before falling back to ctx.Attributes.
+func contextFieldValue(field string, ctx PolicyContext) (interface{}, bool) {
+	switch field {
+	case "operation":
+		return ctx.Operation, true
+	case "user_id":
+		return ctx.UserID, true
+	case "tenant_id":
+		return ctx.TenantID, true
+	case "role":
+		return string(ctx.Role), true
+	case "source_ip":
+		return ctx.SourceIP, true
+	default:
+		v, ok := ctx.Attributes[field]
+		return v, ok
+	}
+}
+
+// resolveVariable resolves a "${...}" placeholder name to its live value.
+func resolveVariable(name string, ctx PolicyContext) interface{} {
+	switch name {
+	case "user_id":
+		return ctx.UserID
+	case "tenant_id":
+		return ctx.TenantID
+	default:
+		return ""
+	}
+}
+
+// toFloat coerces v to a float64 for numeric operators, reporting whether v
+// was a coercible type.
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// toStringSet builds a membership set for the "in" operator from either a
+// []string or a []interface{} (the shape json.Unmarshal produces for a JSON
+// array with no declared element type).
+func toStringSet(v interface{}) map[string]struct{} {
+	set := make(map[string]struct{})
+	switch arr := v.(type) {
+	case []string:
+		for _, item := range arr {
+			set[item] = struct{}{}
+		}
+	case []interface{}:
+		for _, item := range arr {
+			set[fmt.Sprintf("%v", item)] = struct{}{}
+		}
+	}
+	return set
+}
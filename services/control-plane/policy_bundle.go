@@ -0,0 +1,222 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BundleSchemaVersion is the schema version written into every exported
+// bundle's manifest.json.
+const BundleSchemaVersion = "1"
+
+// BundleGenerator identifies the component that produced a bundle.
+const BundleGenerator = "allsource-control-plane"
+
+// BundleManifest describes the contents of a policy bundle.
+type BundleManifest struct {
+	SchemaVersion string    `json:"schema_version"`
+	Generator     string    `json:"generator"`
+	GeneratedAt   time.Time `json:"generated_at"`
+	PolicyCount   int       `json:"policy_count"`
+}
+
+// ImportMode controls how ImportBundle reconciles a bundle against the
+// engine's current policies.
+type ImportMode string
+
+const (
+	// ImportModeMerge keeps existing policies and adds/updates any policy
+	// present in the bundle.
+	ImportModeMerge ImportMode = "merge"
+	// ImportModeReplace wipes all existing policies before loading the bundle.
+	ImportModeReplace ImportMode = "replace"
+	// ImportModeDryRun computes the ImportReport without mutating the engine.
+	ImportModeDryRun ImportMode = "dry_run"
+)
+
+// ImportReport summarizes what an ImportBundle call did (or, for
+// ImportModeDryRun, would do) to the engine's policy set.
+type ImportReport struct {
+	Added     []string          `json:"added"`
+	Updated   []string          `json:"updated"`
+	Unchanged []string          `json:"unchanged"`
+	Removed   []string          `json:"removed"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+// ExportBundle writes the engine's current policies as an OPA-compatible
+// gzipped tar bundle: a manifest.json, one policies/<id>.json per policy,
+// and room for a data/ directory holding lookup tables referenced by
+// conditions (not populated by this engine, but reserved by the format).
+func (pe *PolicyEngine) ExportBundle(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	policies := pe.ListPolicies()
+	sort.Slice(policies, func(i, j int) bool { return policies[i].ID < policies[j].ID })
+
+	manifest := BundleManifest{
+		SchemaVersion: BundleSchemaVersion,
+		Generator:     BundleGenerator,
+		GeneratedAt:   time.Now().UTC(),
+		PolicyCount:   len(policies),
+	}
+	if err := writeBundleJSON(tw, "manifest.json", manifest); err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		name := fmt.Sprintf("policies/%s.json", policy.ID)
+		if err := writeBundleJSON(tw, name, policy); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close bundle tar writer: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("close bundle gzip writer: %w", err)
+	}
+	return nil
+}
+
+// writeBundleJSON marshals v and writes it to tw as a single tar entry.
+func writeBundleJSON(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", name, err)
+	}
+
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now().UTC(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("write %s header: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// ImportBundle reads a gzipped tar bundle produced by ExportBundle (or a
+// compatible OPA-style bundle) and reconciles it against the engine's
+// current policies according to mode. ImportModeDryRun never mutates the
+// engine; it only reports what would change.
+func (pe *PolicyEngine) ImportBundle(r io.Reader, mode ImportMode) (ImportReport, error) {
+	report := ImportReport{Errors: make(map[string]string)}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return report, fmt.Errorf("open bundle gzip stream: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	bundlePolicies := make(map[string]*Policy)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("read bundle tar entry: %w", err)
+		}
+
+		switch {
+		case header.Name == "manifest.json":
+			// Manifest is informational only; nothing to reconcile.
+			continue
+		case strings.HasPrefix(header.Name, "policies/") && strings.HasSuffix(header.Name, ".json"):
+			var policy Policy
+			if err := json.NewDecoder(tr).Decode(&policy); err != nil {
+				id := strings.TrimSuffix(strings.TrimPrefix(header.Name, "policies/"), ".json")
+				report.Errors[id] = fmt.Sprintf("invalid policy JSON: %v", err)
+				continue
+			}
+			if policy.ID == "" {
+				report.Errors[header.Name] = "policy is missing an id"
+				continue
+			}
+			bundlePolicies[policy.ID] = &policy
+		case strings.HasPrefix(header.Name, "data/"):
+			// Lookup-table data is reserved by the bundle format but not
+			// consumed by this engine yet; skip without error.
+			continue
+		}
+	}
+
+	existing := make(map[string]*Policy)
+	for _, policy := range pe.ListPolicies() {
+		existing[policy.ID] = policy
+	}
+
+	for id, incoming := range bundlePolicies {
+		current, exists := existing[id]
+		switch {
+		case !exists:
+			report.Added = append(report.Added, id)
+		case policiesEqual(current, incoming):
+			report.Unchanged = append(report.Unchanged, id)
+		default:
+			report.Updated = append(report.Updated, id)
+		}
+	}
+
+	if mode == ImportModeReplace {
+		for id := range existing {
+			if _, keep := bundlePolicies[id]; !keep {
+				report.Removed = append(report.Removed, id)
+			}
+		}
+	}
+
+	sort.Strings(report.Added)
+	sort.Strings(report.Updated)
+	sort.Strings(report.Unchanged)
+	sort.Strings(report.Removed)
+
+	if mode == ImportModeDryRun {
+		return report, nil
+	}
+
+	if mode == ImportModeReplace {
+		for _, id := range report.Removed {
+			pe.RemovePolicy(id)
+		}
+	}
+
+	for _, id := range append(append([]string{}, report.Added...), report.Updated...) {
+		if err := pe.AddPolicy(bundlePolicies[id]); err != nil {
+			report.Errors[id] = err.Error()
+		}
+	}
+
+	return report, nil
+}
+
+// policiesEqual compares two policies field-by-field, ignoring map/slice
+// identity, to decide whether an incoming bundle entry actually changes
+// anything.
+func policiesEqual(a, b *Policy) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(aJSON) == string(bJSON)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPolicyEngine_ExportImportBundleRoundTrip(t *testing.T) {
+	pe := NewPolicyEngine()
+
+	var buf bytes.Buffer
+	if err := pe.ExportBundle(&buf); err != nil {
+		t.Fatalf("ExportBundle() failed: %v", err)
+	}
+
+	fresh := &PolicyEngine{policies: map[string]*Policy{}, expressions: newExpressionCache()}
+	report, err := fresh.ImportBundle(bytes.NewReader(buf.Bytes()), ImportModeMerge)
+	if err != nil {
+		t.Fatalf("ImportBundle() failed: %v", err)
+	}
+
+	if len(report.Added) != len(pe.ListPolicies()) {
+		t.Errorf("Added = %d, want %d", len(report.Added), len(pe.ListPolicies()))
+	}
+	if len(report.Updated) != 0 || len(report.Unchanged) != 0 {
+		t.Errorf("Expected only additions on first import, got updated=%v unchanged=%v", report.Updated, report.Unchanged)
+	}
+	if len(fresh.ListPolicies()) != len(pe.ListPolicies()) {
+		t.Errorf("fresh engine has %d policies, want %d", len(fresh.ListPolicies()), len(pe.ListPolicies()))
+	}
+}
+
+func TestPolicyEngine_ImportBundleDryRunDoesNotMutate(t *testing.T) {
+	pe := NewPolicyEngine()
+
+	var buf bytes.Buffer
+	if err := pe.ExportBundle(&buf); err != nil {
+		t.Fatalf("ExportBundle() failed: %v", err)
+	}
+
+	fresh := &PolicyEngine{policies: map[string]*Policy{}, expressions: newExpressionCache()}
+	report, err := fresh.ImportBundle(bytes.NewReader(buf.Bytes()), ImportModeDryRun)
+	if err != nil {
+		t.Fatalf("ImportBundle() failed: %v", err)
+	}
+
+	if len(report.Added) == 0 {
+		t.Error("Expected dry-run report to list additions")
+	}
+	if len(fresh.ListPolicies()) != 0 {
+		t.Error("Dry-run import should not mutate the engine")
+	}
+}
+
+func TestPolicyEngine_ImportBundleReplaceRemovesMissingPolicies(t *testing.T) {
+	pe := NewPolicyEngine()
+	pe.AddPolicy(&Policy{
+		ID:       "extra-policy",
+		Name:     "Extra",
+		Resource: "tenant",
+		Action:   ActionAllow,
+		Enabled:  true,
+	})
+
+	var buf bytes.Buffer
+	if err := pe.ExportBundle(&buf); err != nil {
+		t.Fatalf("ExportBundle() failed: %v", err)
+	}
+	pe.RemovePolicy("extra-policy")
+
+	report, err := pe.ImportBundle(bytes.NewReader(buf.Bytes()), ImportModeReplace)
+	if err != nil {
+		t.Fatalf("ImportBundle() failed: %v", err)
+	}
+
+	if len(report.Added) != 1 || report.Added[0] != "extra-policy" {
+		t.Errorf("Added = %v, want [extra-policy]", report.Added)
+	}
+	if _, ok := pe.GetPolicy("extra-policy"); !ok {
+		t.Error("extra-policy should have been re-added by the replace import")
+	}
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/util"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+)
+
+// casbinModelConf is an RBAC-with-domains model: g maps a user to a role
+// within a tenant domain (role inheritance is expressed the same way, e.g.
+// "g, Admin, Developer, *" lets Admin inherit everything Developer can do
+// in every tenant), and keyMatch2 lets a policy's resource be a path
+// pattern like "/tenants/*/users/*" instead of a literal string.
+const casbinModelConf = `
+[request_definition]
+r = sub, dom, obj, act
+
+[policy_definition]
+p = sub, obj, act, eft
+
+[role_definition]
+g = _, _, _
+
+[policy_effect]
+e = some(where (p.eft == allow)) && !some(where (p.eft == deny))
+
+[matchers]
+m = g(r.sub, p.sub, r.dom) && keyMatch2(r.obj, p.obj) && (r.act == p.act || p.act == "*")
+`
+
+// CasbinPolicyEngine is a PolicyEvaluator backed by a Casbin enforcer
+// instead of PolicyEngine's handwritten Conditions/Expression/ConditionTree
+// evaluation. It trades that flexibility for Casbin's role inheritance (g)
+// and path-pattern resource matching (keyMatch2), which the handwritten
+// evaluator has no equivalent for.
+type CasbinPolicyEngine struct {
+	enforcer *casbin.Enforcer
+}
+
+// NewCasbinPolicyEngine builds a Casbin enforcer using casbinModelConf,
+// persisting its ruleset through repo — the same repositories.PolicyRepository
+// Container wires for the Clean Architecture policy stack — instead of one
+// of Casbin's own file/DB adapters.
+func NewCasbinPolicyEngine(repo repositories.PolicyRepository) (*CasbinPolicyEngine, error) {
+	m, err := model.NewModelFromString(casbinModelConf)
+	if err != nil {
+		return nil, fmt.Errorf("casbin policy engine: parse model: %w", err)
+	}
+
+	enforcer, err := casbin.NewEnforcer(m, newPolicyRepositoryAdapter(repo))
+	if err != nil {
+		return nil, fmt.Errorf("casbin policy engine: new enforcer: %w", err)
+	}
+	// Let a grouping rule bound in domain "*" apply in every tenant.
+	enforcer.AddNamedDomainMatchingFunc("g", "KeyMatch", util.KeyMatch)
+
+	return &CasbinPolicyEngine{enforcer: enforcer}, nil
+}
+
+// Evaluate satisfies PolicyEvaluator. PolicyContext is this engine's only
+// source of role bindings (there is no bulk user directory sync), so it
+// records ctx's user/role/tenant as a grouping fact before enforcing;
+// AddGroupingPolicy is a no-op if the fact is already present.
+func (ce *CasbinPolicyEngine) Evaluate(ctx PolicyContext) PolicyResult {
+	if ctx.UserID != "" && ctx.Role != "" {
+		if _, err := ce.enforcer.AddGroupingPolicy(ctx.UserID, string(ctx.Role), ctx.TenantID); err != nil {
+			fmt.Fprintf(os.Stderr, "casbin policy engine: record role binding for %q: %v\n", ctx.UserID, err)
+		}
+	}
+
+	allowed, err := ce.enforcer.Enforce(ctx.UserID, ctx.TenantID, ctx.Resource, ctx.Operation)
+	if err != nil {
+		return PolicyResult{Allowed: false, Action: ActionDeny, Message: fmt.Sprintf("casbin enforcement error: %v", err)}
+	}
+	if !allowed {
+		return PolicyResult{Allowed: false, Action: ActionDeny, Message: "denied by casbin policy"}
+	}
+	return PolicyResult{Allowed: true, Action: ActionAllow}
+}
+
+// BindRole records a static role-inheritance grouping fact (e.g. Admin
+// inheriting Developer's grants) within tenantID, or "*" for every tenant.
+// Unlike the per-user bindings Evaluate records automatically, inheritance
+// is configured explicitly since it changes what a role can do rather than
+// who holds it.
+func (ce *CasbinPolicyEngine) BindRole(role, inheritsFrom, tenantID string) error {
+	_, err := ce.enforcer.AddGroupingPolicy(role, inheritsFrom, tenantID)
+	return err
+}
+
+// AddPolicy translates policy into a Casbin rule via its role and operation
+// Conditions (the same "role"/"operation" fields PolicyEngine.Evaluate
+// reads off PolicyContext) and loads it into the enforcer. Policies with no
+// "role" condition can't be expressed in this model and are rejected.
+func (ce *CasbinPolicyEngine) AddPolicy(policy *Policy) error {
+	role, operation, ok := policyRoleAndOperation(policy)
+	if !ok {
+		return fmt.Errorf("casbin policy engine: policy %q has no role condition to translate", policy.ID)
+	}
+
+	effect := "allow"
+	if policy.Action == ActionDeny {
+		effect = "deny"
+	}
+
+	_, err := ce.enforcer.AddPolicy(role, policy.Resource, operation, effect)
+	return err
+}
+
+// RemovePolicy removes the Casbin rule policy translates to, the inverse of
+// AddPolicy.
+func (ce *CasbinPolicyEngine) RemovePolicy(policy *Policy) error {
+	role, operation, ok := policyRoleAndOperation(policy)
+	if !ok {
+		return fmt.Errorf("casbin policy engine: policy %q has no role condition to translate", policy.ID)
+	}
+
+	effect := "allow"
+	if policy.Action == ActionDeny {
+		effect = "deny"
+	}
+
+	_, err := ce.enforcer.RemovePolicy(role, policy.Resource, operation, effect)
+	return err
+}
+
+// ListPolicies reconstructs a legacy-style Policy for every Casbin p-rule
+// currently loaded, the inverse of AddPolicy's translation.
+func (ce *CasbinPolicyEngine) ListPolicies() []*Policy {
+	rules := ce.enforcer.GetPolicy()
+	policies := make([]*Policy, 0, len(rules))
+	for _, rule := range rules {
+		if len(rule) != 4 {
+			continue
+		}
+		role, resource, operation, effect := rule[0], rule[1], rule[2], rule[3]
+
+		action := ActionAllow
+		if effect == "deny" {
+			action = ActionDeny
+		}
+
+		id := fmt.Sprintf("casbin-%s-%s-%s", role, resource, operation)
+		policies = append(policies, &Policy{
+			ID:       id,
+			Name:     id,
+			Resource: resource,
+			Action:   action,
+			Enabled:  true,
+			Conditions: []PolicyCondition{
+				{Field: "role", Operator: "eq", Value: role},
+				{Field: "operation", Operator: "eq", Value: operation},
+			},
+		})
+	}
+	return policies
+}
+
+// policyRoleAndOperation extracts the "role" and "operation" eq-conditions
+// AddPolicy/RemovePolicy need to translate policy into a Casbin rule.
+// operation defaults to "*" (any operation) when policy has no such
+// condition.
+func policyRoleAndOperation(policy *Policy) (role, operation string, ok bool) {
+	for _, c := range policy.Conditions {
+		if c.Operator != "eq" {
+			continue
+		}
+		switch c.Field {
+		case "role":
+			role = fmt.Sprintf("%v", c.Value)
+		case "operation":
+			operation = fmt.Sprintf("%v", c.Value)
+		}
+	}
+	if role == "" {
+		return "", "", false
+	}
+	if operation == "" {
+		operation = "*"
+	}
+	return role, operation, true
+}
+
+// casbinGroupingResource marks the entities.Policy records a
+// policyRepositoryAdapter uses to persist "g" (grouping) rules, so LoadPolicy
+// can tell them apart from "p" (permission) rules stored in the same
+// repository.
+const casbinGroupingResource = "__casbin_grouping__"
+
+// policyRepositoryAdapter implements casbin's persist.Adapter against a
+// repositories.PolicyRepository, so a CasbinPolicyEngine's ruleset persists
+// through the same interface Container wires for every other policy store,
+// instead of one of Casbin's built-in file/DB adapters.
+type policyRepositoryAdapter struct {
+	repo repositories.PolicyRepository
+}
+
+func newPolicyRepositoryAdapter(repo repositories.PolicyRepository) *policyRepositoryAdapter {
+	return &policyRepositoryAdapter{repo: repo}
+}
+
+// LoadPolicy reads every enabled policy from the repository and adds it to
+// m as either a "p" or "g" line, via ruleFromEntity.
+func (a *policyRepositoryAdapter) LoadPolicy(m model.Model) error {
+	policies, err := a.repo.FindAll()
+	if err != nil {
+		return fmt.Errorf("casbin adapter: load policies: %w", err)
+	}
+
+	for _, policy := range policies {
+		if !policy.Enabled {
+			continue
+		}
+		sec, rule, ok := ruleFromEntity(policy)
+		if !ok {
+			continue
+		}
+		m.AddPolicy(sec, sec, rule)
+	}
+	return nil
+}
+
+// SavePolicy is unsupported: this adapter persists incrementally through
+// AddPolicy/RemovePolicy, which is all CasbinPolicyEngine ever triggers, so
+// a full-ruleset dump is never needed.
+func (a *policyRepositoryAdapter) SavePolicy(m model.Model) error {
+	return fmt.Errorf("casbin adapter: SavePolicy is unsupported, policies persist incrementally via AddPolicy/RemovePolicy")
+}
+
+// AddPolicy persists one casbin rule line as an entities.Policy.
+func (a *policyRepositoryAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	policy, err := entityFromRule(sec, rule)
+	if err != nil {
+		return err
+	}
+	if err := a.repo.Save(policy); err != nil {
+		return fmt.Errorf("casbin adapter: save rule: %w", err)
+	}
+	return nil
+}
+
+// RemovePolicy deletes the entities.Policy AddPolicy stored for this rule.
+func (a *policyRepositoryAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	policy, err := entityFromRule(sec, rule)
+	if err != nil {
+		return err
+	}
+	if err := a.repo.Delete(policy.ID); err != nil {
+		return fmt.Errorf("casbin adapter: delete rule: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilteredPolicy is unsupported: nothing in CasbinPolicyEngine calls
+// it today (rules are always removed by their exact fields via
+// RemovePolicy), and implementing a partial-field match against the
+// repository's List/FindAll interface isn't worth the complexity until
+// something needs it.
+func (a *policyRepositoryAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return fmt.Errorf("casbin adapter: RemoveFilteredPolicy is unsupported")
+}
+
+// entityFromRule is AddPolicy/RemovePolicy's translation from a casbin rule
+// line into the entities.Policy a policyRepositoryAdapter stores it as, the
+// inverse of ruleFromEntity. sec must be "p" or "g".
+func entityFromRule(sec string, rule []string) (*entities.Policy, error) {
+	id := "casbin-rule-" + sec + "-" + strings.Join(rule, "-")
+
+	switch sec {
+	case "p":
+		if len(rule) != 4 {
+			return nil, fmt.Errorf("casbin adapter: p rule needs 4 fields, got %d", len(rule))
+		}
+		role, resource, operation, effect := rule[0], rule[1], rule[2], rule[3]
+		action := entities.ActionAllow
+		if effect == "deny" {
+			action = entities.ActionDeny
+		}
+		return &entities.Policy{
+			ID:       id,
+			Name:     id,
+			Resource: resource,
+			Action:   action,
+			Enabled:  true,
+			Conditions: []entities.PolicyCondition{
+				{Field: "role", Operator: "eq", Value: role},
+				{Field: "operation", Operator: "eq", Value: operation},
+			},
+		}, nil
+	case "g":
+		if len(rule) != 3 {
+			return nil, fmt.Errorf("casbin adapter: g rule needs 3 fields, got %d", len(rule))
+		}
+		return &entities.Policy{
+			ID:       id,
+			Name:     id,
+			Resource: casbinGroupingResource,
+			Action:   entities.ActionAllow,
+			Enabled:  true,
+			Conditions: []entities.PolicyCondition{
+				{Field: "user", Operator: "eq", Value: rule[0]},
+				{Field: "role", Operator: "eq", Value: rule[1]},
+				{Field: "tenant_id", Operator: "eq", Value: rule[2]},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("casbin adapter: unsupported section %q", sec)
+	}
+}
+
+// ruleFromEntity is LoadPolicy's translation from a stored entities.Policy
+// back into a casbin rule line, the inverse of entityFromRule.
+func ruleFromEntity(policy *entities.Policy) (sec string, rule []string, ok bool) {
+	if policy.Resource == casbinGroupingResource {
+		user, uOK := entityConditionValue(policy.Conditions, "user")
+		role, rOK := entityConditionValue(policy.Conditions, "role")
+		tenant, tOK := entityConditionValue(policy.Conditions, "tenant_id")
+		if !uOK || !rOK || !tOK {
+			return "", nil, false
+		}
+		return "g", []string{user, role, tenant}, true
+	}
+
+	role, rOK := entityConditionValue(policy.Conditions, "role")
+	if !rOK {
+		return "", nil, false
+	}
+	operation, oOK := entityConditionValue(policy.Conditions, "operation")
+	if !oOK {
+		operation = "*"
+	}
+
+	effect := "allow"
+	if policy.Action == entities.ActionDeny {
+		effect = "deny"
+	}
+	return "p", []string{role, policy.Resource, operation, effect}, true
+}
+
+// entityConditionValue returns the value of the first eq-condition on
+// field, if any.
+func entityConditionValue(conditions []entities.PolicyCondition, field string) (string, bool) {
+	for _, c := range conditions {
+		if c.Field == field && c.Operator == "eq" {
+			return fmt.Sprintf("%v", c.Value), true
+		}
+	}
+	return "", false
+}
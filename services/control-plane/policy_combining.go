@@ -0,0 +1,189 @@
+package main
+
+// CombiningAlgorithm selects how Evaluate and Simulate reduce every policy
+// that matched a request into a single decision, XACML-style, instead of
+// the old "first matching deny wins" rule.
+type CombiningAlgorithm string
+
+const (
+	// CombineDenyOverrides returns Deny if any matched policy denies,
+	// otherwise the highest-priority matched permit (Allow or Warn). This
+	// is the algorithm Evaluate used implicitly before CombiningAlgorithm
+	// existed, and remains the default.
+	CombineDenyOverrides CombiningAlgorithm = "deny-overrides"
+	// CombinePermitOverrides returns the highest-priority matched permit if
+	// any exists, otherwise the highest-priority matched deny.
+	CombinePermitOverrides CombiningAlgorithm = "permit-overrides"
+	// CombineFirstApplicable returns whichever policy matched first in
+	// priority order, ignoring every policy considered afterward.
+	CombineFirstApplicable CombiningAlgorithm = "first-applicable"
+	// CombineDenyUnlessPermit returns Permit if any matched policy permits,
+	// otherwise Deny — it never returns Indeterminate, even when a
+	// condition couldn't be evaluated.
+	CombineDenyUnlessPermit CombiningAlgorithm = "deny-unless-permit"
+	// CombinePermitUnlessDeny returns Deny if any matched policy denies,
+	// otherwise Permit — it never returns Indeterminate either.
+	CombinePermitUnlessDeny CombiningAlgorithm = "permit-unless-deny"
+)
+
+// DefaultCombiningAlgorithm is used for any resource without an explicit
+// algorithm set via SetCombiningAlgorithm or SetDefaultCombiningAlgorithm.
+const DefaultCombiningAlgorithm = CombineDenyOverrides
+
+// Obligation is metadata a matched policy attaches to a PolicyResult for
+// the caller to act on regardless of whether the request was ultimately
+// allowed or denied, e.g. "require MFA" or "log to SIEM". PolicyMiddleware
+// carries these through so obligations from a Warn (or even an overridden
+// Allow) policy aren't lost just because a different policy decided the
+// request.
+type Obligation struct {
+	PolicyID   string                 `json:"policy_id"`
+	ID         string                 `json:"id"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// SetCombiningAlgorithm sets the algorithm Evaluate and Simulate use to
+// reduce matched policies for resource. Resources without one fall back to
+// SetDefaultCombiningAlgorithm's value, or DefaultCombiningAlgorithm.
+func (pe *PolicyEngine) SetCombiningAlgorithm(resource string, algo CombiningAlgorithm) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	if pe.combiningAlgorithms == nil {
+		pe.combiningAlgorithms = make(map[string]CombiningAlgorithm)
+	}
+	pe.combiningAlgorithms[resource] = algo
+}
+
+// SetDefaultCombiningAlgorithm sets the algorithm used for any resource
+// without its own entry set via SetCombiningAlgorithm.
+func (pe *PolicyEngine) SetDefaultCombiningAlgorithm(algo CombiningAlgorithm) {
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	pe.defaultCombiningAlgorithm = algo
+}
+
+// combiningAlgorithmLocked returns the algorithm to use for resource.
+// Callers must hold pe.mu (for reading or writing).
+func (pe *PolicyEngine) combiningAlgorithmLocked(resource string) CombiningAlgorithm {
+	if algo, ok := pe.combiningAlgorithms[resource]; ok {
+		return algo
+	}
+	if pe.defaultCombiningAlgorithm != "" {
+		return pe.defaultCombiningAlgorithm
+	}
+	return DefaultCombiningAlgorithm
+}
+
+// evaluateConditionTri is evaluateCondition, but distinguishes a definite
+// non-match from one where condition.Field couldn't be resolved against
+// ctx (a missing attribute), so callers can surface Indeterminate instead
+// of silently treating "cannot evaluate" as "denied".
+func (pe *PolicyEngine) evaluateConditionTri(condition PolicyCondition, ctx PolicyContext) (matched, indeterminate bool) {
+	if _, ok := contextFieldValue(condition.Field, ctx); !ok {
+		return false, true
+	}
+	return pe.evaluateCondition(condition, ctx), false
+}
+
+// evaluateConditionsTri ANDs conditions together. A definite non-match
+// anywhere short-circuits to (false, false) regardless of any indeterminate
+// condition elsewhere in the list, since a policy that can't possibly match
+// isn't worth calling Indeterminate. Otherwise, if at least one condition
+// was indeterminate, the result is (false, true).
+func (pe *PolicyEngine) evaluateConditionsTri(conditions []PolicyCondition, ctx PolicyContext) (matched, indeterminate bool) {
+	sawIndeterminate := false
+	for _, condition := range conditions {
+		m, ind := pe.evaluateConditionTri(condition, ctx)
+		if ind {
+			sawIndeterminate = true
+			continue
+		}
+		if !m {
+			return false, false
+		}
+	}
+	if sawIndeterminate {
+		return false, true
+	}
+	return true, false
+}
+
+// decided builds the PolicyResult for policy having won the combining
+// reduction, preserving result's already-collected Obligations.
+func decided(result PolicyResult, policy *Policy) PolicyResult {
+	result.PolicyID = policy.ID
+	result.Message = policy.Description
+
+	if policy.Action == ActionDeny {
+		result.Allowed = false
+		result.Action = ActionDeny
+	} else {
+		result.Allowed = true
+		result.Action = policy.Action
+	}
+	return result
+}
+
+// reduceDecision applies algo to the policies that matched a single
+// Evaluate/Simulate pass (denies and permits, in priority order) and
+// returns the resulting PolicyResult, with obligations from every matched
+// policy attached regardless of which one decided the outcome.
+func reduceDecision(algo CombiningAlgorithm, denies, permits []*Policy, obligations []Obligation, indeterminate bool) PolicyResult {
+	result := PolicyResult{Obligations: obligations}
+
+	switch algo {
+	case CombinePermitOverrides:
+		if len(permits) > 0 {
+			return decided(result, permits[0])
+		}
+		if len(denies) > 0 {
+			return decided(result, denies[0])
+		}
+	case CombineFirstApplicable:
+		// The caller stops collecting after the first match for this
+		// algorithm, so at most one of denies/permits has an entry.
+		if len(denies) > 0 {
+			return decided(result, denies[0])
+		}
+		if len(permits) > 0 {
+			return decided(result, permits[0])
+		}
+	case CombineDenyUnlessPermit:
+		if len(permits) > 0 {
+			return decided(result, permits[0])
+		}
+		result.Allowed = false
+		result.Action = ActionDeny
+		result.Message = "deny-unless-permit: no permitting policy matched"
+		return result
+	case CombinePermitUnlessDeny:
+		if len(denies) > 0 {
+			return decided(result, denies[0])
+		}
+		result.Allowed = true
+		result.Action = ActionAllow
+		result.Message = "permit-unless-deny: no denying policy matched"
+		return result
+	default: // CombineDenyOverrides
+		if len(denies) > 0 {
+			return decided(result, denies[0])
+		}
+		if len(permits) > 0 {
+			return decided(result, permits[0])
+		}
+	}
+
+	if indeterminate {
+		result.Allowed = false
+		result.Action = ActionIndeterminate
+		result.Message = "a matched policy's condition referenced a missing attribute"
+		return result
+	}
+
+	result.Allowed = true
+	result.Action = ActionAllow
+	result.Message = "No policy matched, default allow"
+	return result
+}
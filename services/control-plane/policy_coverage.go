@@ -0,0 +1,113 @@
+package main
+
+import (
+	"sort"
+	"sync"
+)
+
+// hitRingCapacity bounds how many policy evaluation outcomes
+// policyHitRing retains for GET /policies/coverage. Older hits are
+// overwritten once the ring fills, so coverage reflects recent traffic
+// rather than growing without bound.
+const hitRingCapacity = 2000
+
+// PolicyHitRecord is one observation of a policy firing during request
+// evaluation, recorded by AuditLogger.recordPolicyHit.
+type PolicyHitRecord struct {
+	PolicyID string
+	Action   PolicyAction
+}
+
+// policyHitRing is a bounded, concurrency-safe ring buffer of the most
+// recently recorded PolicyHitRecords.
+type policyHitRing struct {
+	mu      sync.Mutex
+	entries []PolicyHitRecord
+	next    int
+	full    bool
+}
+
+func newPolicyHitRing() *policyHitRing {
+	return &policyHitRing{entries: make([]PolicyHitRecord, hitRingCapacity)}
+}
+
+func (r *policyHitRing) record(hit PolicyHitRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[r.next] = hit
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// snapshot returns up to n of the most recently recorded hits, most recent
+// first. n <= 0 returns every hit still retained.
+func (r *policyHitRing) snapshot(n int) []PolicyHitRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	size := r.next
+	if r.full {
+		size = len(r.entries)
+	}
+	if n <= 0 || n > size {
+		n = size
+	}
+
+	out := make([]PolicyHitRecord, 0, n)
+	for i := 0; i < n; i++ {
+		idx := (r.next - 1 - i + len(r.entries)) % len(r.entries)
+		out = append(out, r.entries[idx])
+	}
+	return out
+}
+
+// PolicyCoverageEntry reports how often one policy fired, classified by
+// the action it fired with, over the hits PolicyCoverage inspected.
+type PolicyCoverageEntry struct {
+	PolicyID string `json:"policy_id"`
+	Matched  int    `json:"matched"`
+	Denied   int    `json:"denied"`
+	Warned   int    `json:"warned"`
+	NeverHit bool   `json:"never_hit"`
+}
+
+// PolicyCoverage cross-references every policy currently loaded in pe
+// against the last n hits recorded by logger (n <= 0 means every hit
+// logger still retains), so operators can spot rules that never fire and
+// are safe to remove. A hit for a policy ID no longer loaded in pe (e.g.
+// one since deleted) is still reported, since it's exactly the kind of
+// stale rule this endpoint exists to surface.
+func PolicyCoverage(pe *PolicyEngine, logger *AuditLogger, n int) []PolicyCoverageEntry {
+	entries := make(map[string]*PolicyCoverageEntry)
+	for _, policy := range pe.ListPolicies() {
+		entries[policy.ID] = &PolicyCoverageEntry{PolicyID: policy.ID, NeverHit: true}
+	}
+
+	for _, hit := range logger.RecentPolicyHits(n) {
+		entry, ok := entries[hit.PolicyID]
+		if !ok {
+			entry = &PolicyCoverageEntry{PolicyID: hit.PolicyID}
+			entries[hit.PolicyID] = entry
+		}
+		entry.NeverHit = false
+
+		switch hit.Action {
+		case ActionDeny:
+			entry.Denied++
+		case ActionWarn:
+			entry.Warned++
+		default:
+			entry.Matched++
+		}
+	}
+
+	report := make([]PolicyCoverageEntry, 0, len(entries))
+	for _, entry := range entries {
+		report = append(report, *entry)
+	}
+	sort.Slice(report, func(i, j int) bool { return report[i].PolicyID < report[j].PolicyID })
+	return report
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/infrastructure/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// policyDecisionContextKey is the Gin context key PolicyEnforcementMiddleware
+// stores its entities.Decision under, for AuditMiddleware (and any
+// downstream handler) to read back.
+const policyDecisionContextKey = "policy_decision"
+
+// PolicyEnforcementMiddleware evaluates ps against every request's derived
+// attributes and aborts with 403 on deny. It must run ahead of handlers but
+// be registered behind AuditMiddleware, so that AuditMiddleware's c.Next()
+// call reaches this middleware and its post-c.Next() logging can read back
+// the decision regardless of whether the request was denied.
+func PolicyEnforcementMiddleware(ps *entities.PolicySet) gin.HandlerFunc {
+	logger := logging.Get("policy")
+
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == "/health" || c.Request.URL.Path == "/metrics" {
+			c.Next()
+			return
+		}
+
+		decision := ps.Decide(c.Request.Context(), policyAttributesFor(c), entities.CombineDenyOverrides)
+		c.Set(policyDecisionContextKey, decision)
+
+		switch decision.Effect {
+		case entities.ActionDeny:
+			logger.Warn("denied %s %s: policy=%s reasons=%v", c.Request.Method, c.Request.URL.Path, decision.MatchedPolicyID, decision.Reasons)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":             "forbidden",
+				"matched_policy_id": decision.MatchedPolicyID,
+				"reasons":           decision.Reasons,
+			})
+			return
+		case entities.ActionWarn:
+			logger.Warn("warned %s %s: policy=%s reasons=%v", c.Request.Method, c.Request.URL.Path, decision.MatchedPolicyID, decision.Reasons)
+		}
+
+		c.Next()
+	}
+}
+
+// policyAttributesFor builds the attribute map PolicySet.Decide evaluates:
+// auth context, HTTP method/path/resource/operation, and client IP.
+func policyAttributesFor(c *gin.Context) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"method":    c.Request.Method,
+		"path":      c.Request.URL.Path,
+		"resource":  extractResource(c.Request.URL.Path),
+		"operation": determineAction(c.Request.Method, c.Request.URL.Path),
+		"ip":        c.ClientIP(),
+		"time":      time.Now(),
+	}
+
+	if auth, exists := c.Get("auth"); exists {
+		if authCtx, ok := auth.(*AuthContext); ok {
+			attributes["user_id"] = authCtx.UserID
+			attributes["username"] = authCtx.Username
+			attributes["tenant_id"] = authCtx.TenantID
+			attributes["role"] = string(authCtx.Role)
+		}
+	}
+
+	return attributes
+}
+
+// decisionFromContext retrieves the Decision PolicyEnforcementMiddleware
+// attached to c, if it ran for this request.
+func decisionFromContext(c *gin.Context) (entities.Decision, bool) {
+	value, exists := c.Get(policyDecisionContextKey)
+	if !exists {
+		return entities.Decision{}, false
+	}
+	decision, ok := value.(entities.Decision)
+	return decision, ok
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// ExpressionLanguage identifies which engine should evaluate Policy.Expression.
+type ExpressionLanguage string
+
+const (
+	ExpressionLanguageCEL       ExpressionLanguage = "cel"
+	ExpressionLanguageRego      ExpressionLanguage = "rego"
+	ExpressionLanguageJSONLogic ExpressionLanguage = "jsonlogic"
+)
+
+// ConditionEvaluator compiles and evaluates a Policy.Expression against a
+// PolicyContext. PolicyEngine keeps each policy's compiled handle in its
+// expressionCache, keyed by policy ID, since Evaluate is called on every
+// request that touches the policy's resource.
+type ConditionEvaluator interface {
+	// Compile parses and type-checks expr, returning an opaque handle that
+	// Evaluate can use without re-parsing.
+	Compile(expr string) (interface{}, error)
+
+	// Evaluate runs a previously compiled handle against ctx.
+	Evaluate(compiled interface{}, ctx PolicyContext) (bool, error)
+}
+
+// celEvaluator implements ConditionEvaluator using google/cel-go. Expressions
+// see the evaluation context as `ctx`, e.g.
+//
+//	ctx.role == "Admin" && ctx.attributes.record_count < 10000
+type celEvaluator struct {
+	env *cel.Env
+}
+
+func newCELEvaluator() (*celEvaluator, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("ctx", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	return &celEvaluator{env: env}, nil
+}
+
+// Compile parses and type-checks a CEL expression, returning a cel.Program
+// ready for repeated evaluation.
+func (e *celEvaluator) Compile(expr string) (interface{}, error) {
+	ast, issues := e.env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression: %w", issues.Err())
+	}
+
+	prg, err := e.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build CEL program: %w", err)
+	}
+
+	return prg, nil
+}
+
+// Evaluate runs a compiled cel.Program against the PolicyContext.
+func (e *celEvaluator) Evaluate(compiled interface{}, ctx PolicyContext) (bool, error) {
+	prg, ok := compiled.(cel.Program)
+	if !ok {
+		return false, fmt.Errorf("invalid compiled expression type %T", compiled)
+	}
+
+	out, _, err := prg.Eval(map[string]interface{}{
+		"ctx": map[string]interface{}{
+			"resource":   ctx.Resource,
+			"operation":  ctx.Operation,
+			"user_id":    ctx.UserID,
+			"tenant_id":  ctx.TenantID,
+			"role":       string(ctx.Role),
+			"attributes": ctx.Attributes,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate CEL expression: %w", err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("CEL expression must evaluate to a bool, got %T", out.Value())
+	}
+
+	return result, nil
+}
+
+// conditionEvaluators maps each supported ExpressionLanguage to its
+// ConditionEvaluator. Rego and JSONLogic are accepted as valid values on
+// Policy so callers can plan for them, but only CEL has a working
+// implementation today.
+var conditionEvaluators = map[ExpressionLanguage]ConditionEvaluator{}
+
+func init() {
+	evaluator, err := newCELEvaluator()
+	if err != nil {
+		panic(fmt.Sprintf("policy: failed to initialize CEL evaluator: %v", err))
+	}
+	conditionEvaluators[ExpressionLanguageCEL] = evaluator
+}
+
+// expressionCache holds compiled expressions keyed by policy ID, so
+// PolicyEngine.Evaluate never re-parses an expression on the hot path.
+type expressionCache struct {
+	mu       sync.RWMutex
+	compiled map[string]interface{}
+}
+
+func newExpressionCache() *expressionCache {
+	return &expressionCache{compiled: make(map[string]interface{})}
+}
+
+func (c *expressionCache) get(policyID string) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	compiled, ok := c.compiled[policyID]
+	return compiled, ok
+}
+
+func (c *expressionCache) set(policyID string, compiled interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.compiled[policyID] = compiled
+}
+
+func (c *expressionCache) delete(policyID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.compiled, policyID)
+}
+
+// evaluateExpression compiles (on first use) and evaluates policy.Expression
+// against ctx, using pe.expressions to avoid recompiling on every call.
+func (pe *PolicyEngine) evaluateExpression(policy *Policy, ctx PolicyContext) (bool, error) {
+	if policy.Expression == "" {
+		return true, nil
+	}
+
+	lang := policy.ExpressionLanguage
+	if lang == "" {
+		lang = ExpressionLanguageCEL
+	}
+
+	evaluator, ok := conditionEvaluators[lang]
+	if !ok {
+		return false, fmt.Errorf("no evaluator registered for expression language %q", lang)
+	}
+
+	compiled, ok := pe.expressions.get(policy.ID)
+	if !ok {
+		var err error
+		compiled, err = evaluator.Compile(policy.Expression)
+		if err != nil {
+			return false, err
+		}
+		pe.expressions.set(policy.ID, compiled)
+	}
+
+	return evaluator.Evaluate(compiled, ctx)
+}
+
+// evaluatePolicyExpression adapts evaluateExpression's (bool, error) return
+// to the bool-only signature Evaluate's condition checks use, since
+// Evaluate's hot path treats a policy as non-matching rather than
+// propagating expression errors up through PolicyResult.
+func (pe *PolicyEngine) evaluatePolicyExpression(policy *Policy, ctx PolicyContext) bool {
+	matched, err := pe.evaluateExpression(policy, ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "policy %q: expression evaluation failed: %v\n", policy.ID, err)
+		return false
+	}
+	return matched
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+)
+
+func TestPolicyEngine_ExpressionMatch(t *testing.T) {
+	pe := NewPolicyEngine()
+
+	err := pe.AddPolicy(&Policy{
+		ID:                 "deny-large-default-tenant-reads",
+		Name:                "Deny Large Default Tenant Reads",
+		Resource:            "expr-test",
+		Action:              ActionDeny,
+		Expression:          `ctx.tenant_id == "default" && ctx.attributes.record_count > 1000`,
+		ExpressionLanguage:  ExpressionLanguageCEL,
+		Priority:            100,
+		Enabled:             true,
+	})
+	if err != nil {
+		t.Fatalf("AddPolicy() with expression failed: %v", err)
+	}
+
+	result := pe.Evaluate(PolicyContext{
+		Resource: "expr-test",
+		TenantID: "default",
+		Attributes: map[string]interface{}{
+			"record_count": 5000,
+		},
+	})
+
+	if result.Allowed {
+		t.Error("expected expression-matched policy to deny")
+	}
+	if result.PolicyID != "deny-large-default-tenant-reads" {
+		t.Errorf("expected matched policy ID, got %q", result.PolicyID)
+	}
+}
+
+func TestPolicyEngine_ExpressionAndConditionsAreANDed(t *testing.T) {
+	pe := NewPolicyEngine()
+
+	err := pe.AddPolicy(&Policy{
+		ID:       "deny-combo",
+		Name:     "Deny Combo",
+		Resource: "expr-test-2",
+		Action:   ActionDeny,
+		Conditions: []PolicyCondition{
+			{Field: "operation", Operator: "eq", Value: "delete"},
+		},
+		Expression:         `ctx.role == "Developer"`,
+		ExpressionLanguage:  ExpressionLanguageCEL,
+		Priority:            100,
+		Enabled:             true,
+	})
+	if err != nil {
+		t.Fatalf("AddPolicy() failed: %v", err)
+	}
+
+	// Conditions match but expression doesn't -> allowed.
+	result := pe.Evaluate(PolicyContext{
+		Resource:  "expr-test-2",
+		Operation: "delete",
+		Role:      entities.RoleAdmin,
+	})
+	if !result.Allowed {
+		t.Error("expected allow when expression does not match even though conditions do")
+	}
+
+	// Both match -> denied.
+	result = pe.Evaluate(PolicyContext{
+		Resource:  "expr-test-2",
+		Operation: "delete",
+		Role:      entities.RoleDeveloper,
+	})
+	if result.Allowed {
+		t.Error("expected deny when both conditions and expression match")
+	}
+}
+
+func TestPolicyEngine_AddPolicyRejectsInvalidExpression(t *testing.T) {
+	pe := NewPolicyEngine()
+
+	err := pe.AddPolicy(&Policy{
+		ID:                 "broken-expr",
+		Name:                "Broken Expression",
+		Resource:            "expr-test-3",
+		Action:              ActionDeny,
+		Expression:          `ctx.role ===`,
+		ExpressionLanguage:  ExpressionLanguageCEL,
+		Priority:            1,
+		Enabled:             true,
+	})
+	if err == nil {
+		t.Error("expected AddPolicy to reject a malformed CEL expression")
+	}
+}
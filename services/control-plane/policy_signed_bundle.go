@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultBundlePollInterval is how often PolicyBundlePoller re-fetches the
+// bundle URL when POLICY_BUNDLE_POLL_INTERVAL isn't set.
+const DefaultBundlePollInterval = 1 * time.Minute
+
+// SignedBundle is a JSON, Ed25519-signed set of policies meant for GitOps
+// distribution: authored in a repo, signed by CI, and pulled by each
+// control plane node via PolicyBundlePoller or POST /policies/bundles.
+// Unlike the tar-based ExportBundle/ImportBundle pair, a SignedBundle
+// carries its own Revision and Signature, so a node can verify authenticity
+// and reject a stale or tampered bundle before applying it.
+type SignedBundle struct {
+	Revision  uint64    `json:"revision"`
+	Policies  []*Policy `json:"policies"`
+	Signature []byte    `json:"signature"`
+}
+
+// signingPayload returns the canonical bytes ExportSignedBundle signs and
+// LoadSignedBundle verifies: revision and policies sorted by ID, with the
+// Signature field itself excluded.
+func signingPayload(revision uint64, policies []*Policy) ([]byte, error) {
+	sorted := make([]*Policy, len(policies))
+	copy(sorted, policies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	payload := struct {
+		Revision uint64    `json:"revision"`
+		Policies []*Policy `json:"policies"`
+	}{Revision: revision, Policies: sorted}
+
+	return json.Marshal(payload)
+}
+
+// ExportSignedBundle serializes the engine's current policies as a
+// SignedBundle at the given revision, signed with priv. The result is
+// ready for distribution via POST /policies/bundles or a GitOps repo pulled
+// by PolicyBundlePoller.
+func (pe *PolicyEngine) ExportSignedBundle(revision uint64, priv ed25519.PrivateKey) ([]byte, error) {
+	policies := pe.ListPolicies()
+
+	payload, err := signingPayload(revision, policies)
+	if err != nil {
+		return nil, fmt.Errorf("marshal signed bundle payload: %w", err)
+	}
+
+	bundle := SignedBundle{
+		Revision:  revision,
+		Policies:  policies,
+		Signature: ed25519.Sign(priv, payload),
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("marshal signed bundle: %w", err)
+	}
+	return data, nil
+}
+
+// CurrentRevision returns the revision of the last bundle successfully
+// loaded via LoadSignedBundle, or 0 if none has been loaded yet.
+func (pe *PolicyEngine) CurrentRevision() uint64 {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+	return pe.revision
+}
+
+// LoadSignedBundle verifies r against trustedKeys, rejects it if its
+// revision is older than the engine's current revision, and, if it passes,
+// atomically replaces the engine's ruleset with the bundle's policies.
+func (pe *PolicyEngine) LoadSignedBundle(r io.Reader, trustedKeys []ed25519.PublicKey) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("read signed bundle: %w", err)
+	}
+
+	var bundle SignedBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return fmt.Errorf("decode signed bundle: %w", err)
+	}
+
+	payload, err := signingPayload(bundle.Revision, bundle.Policies)
+	if err != nil {
+		return fmt.Errorf("marshal signed bundle payload: %w", err)
+	}
+
+	if !verifiedByAny(trustedKeys, payload, bundle.Signature) {
+		return fmt.Errorf("signed bundle: signature does not match any trusted key")
+	}
+
+	if bundle.Revision < pe.CurrentRevision() {
+		return fmt.Errorf("signed bundle: revision %d is older than current revision %d", bundle.Revision, pe.CurrentRevision())
+	}
+
+	newPolicies, newOrder, newCompiledTrees, newExpressions, err := compileBundlePolicies(bundle.Policies)
+	if err != nil {
+		return fmt.Errorf("signed bundle: %w", err)
+	}
+
+	pe.mu.Lock()
+	if bundle.Revision < pe.revision {
+		current := pe.revision
+		pe.mu.Unlock()
+		return fmt.Errorf("signed bundle: revision %d is older than current revision %d", bundle.Revision, current)
+	}
+	pe.policies = newPolicies
+	pe.order = newOrder
+	pe.compiledTrees = newCompiledTrees
+	pe.expressions = newExpressions
+	pe.revision = bundle.Revision
+	pe.mu.Unlock()
+
+	return nil
+}
+
+// compileBundlePolicies compiles every policy's expression and condition
+// tree into a fresh set of engine indices, entirely independent of the
+// live engine state. Building (and validating) the replacement this way,
+// then swapping every field in a single lock acquisition in
+// LoadSignedBundle, is what makes that swap atomic: a concurrent Evaluate
+// sees either the full old ruleset or the full new one, never a partial
+// mix, and a compile failure here never leaves the live engine modified.
+func compileBundlePolicies(policies []*Policy) (map[string]*Policy, []*Policy, map[string]compiledNode, *expressionCache, error) {
+	newPolicies := make(map[string]*Policy, len(policies))
+	newCompiledTrees := make(map[string]compiledNode)
+	newExpressions := newExpressionCache()
+
+	for _, policy := range policies {
+		if policy.Expression != "" {
+			lang := policy.ExpressionLanguage
+			if lang == "" {
+				lang = ExpressionLanguageCEL
+			}
+			evaluator, ok := conditionEvaluators[lang]
+			if !ok {
+				return nil, nil, nil, nil, fmt.Errorf("no evaluator registered for expression language %q", lang)
+			}
+			compiled, err := evaluator.Compile(policy.Expression)
+			if err != nil {
+				return nil, nil, nil, nil, fmt.Errorf("policy %q: %w", policy.ID, err)
+			}
+			newExpressions.set(policy.ID, compiled)
+		}
+
+		if policy.ConditionTree != nil {
+			compiled, err := compilePolicyExpression(policy.ConditionTree)
+			if err != nil {
+				return nil, nil, nil, nil, fmt.Errorf("policy %q: %w", policy.ID, err)
+			}
+			newCompiledTrees[policy.ID] = compiled
+		}
+
+		newPolicies[policy.ID] = policy
+	}
+
+	newOrder := make([]*Policy, len(policies))
+	copy(newOrder, policies)
+	sort.Slice(newOrder, func(i, j int) bool { return newOrder[i].Priority > newOrder[j].Priority })
+
+	return newPolicies, newOrder, newCompiledTrees, newExpressions, nil
+}
+
+// verifiedByAny reports whether signature verifies against payload for at
+// least one of trustedKeys.
+func verifiedByAny(trustedKeys []ed25519.PublicKey, payload, signature []byte) bool {
+	for _, key := range trustedKeys {
+		if ed25519.Verify(key, payload, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+// PolicyBundlePoller periodically fetches a SignedBundle from a GitOps
+// endpoint and loads it into Engine, so policies authored and signed
+// outside this process (e.g. in CI) reach the running engine without a
+// restart or a manual POST /policies/bundles call. It sends the ETag of
+// the last bundle it loaded successfully as If-None-Match on each poll, so
+// a 304 short-circuits the fetch when nothing has changed.
+type PolicyBundlePoller struct {
+	URL         string
+	Interval    time.Duration
+	Engine      *PolicyEngine
+	TrustedKeys []ed25519.PublicKey
+
+	client *resty.Client
+	etag   string
+}
+
+// NewPolicyBundlePoller creates a poller for url that loads bundles into
+// engine, verifying them against trustedKeys. A zero interval falls back
+// to DefaultBundlePollInterval.
+func NewPolicyBundlePoller(url string, interval time.Duration, engine *PolicyEngine, trustedKeys []ed25519.PublicKey) *PolicyBundlePoller {
+	if interval <= 0 {
+		interval = DefaultBundlePollInterval
+	}
+	return &PolicyBundlePoller{
+		URL:         url,
+		Interval:    interval,
+		Engine:      engine,
+		TrustedKeys: trustedKeys,
+		client:      resty.New().SetTimeout(10 * time.Second),
+	}
+}
+
+// Run blocks, polling p.URL on each tick until ctx is cancelled.
+func (p *PolicyBundlePoller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	p.poll()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.poll()
+		}
+	}
+}
+
+// poll fetches p.URL once and, on a new bundle, loads it into p.Engine.
+func (p *PolicyBundlePoller) poll() {
+	req := p.client.R()
+	if p.etag != "" {
+		req.SetHeader("If-None-Match", p.etag)
+	}
+
+	resp, err := req.Get(p.URL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "policy bundle poller: fetch %s: %v\n", p.URL, err)
+		return
+	}
+
+	if resp.StatusCode() == 304 {
+		return
+	}
+	if resp.IsError() {
+		fmt.Fprintf(os.Stderr, "policy bundle poller: fetch %s: status %d\n", p.URL, resp.StatusCode())
+		return
+	}
+
+	if err := p.Engine.LoadSignedBundle(bytes.NewReader(resp.Body()), p.TrustedKeys); err != nil {
+		fmt.Fprintf(os.Stderr, "policy bundle poller: load bundle from %s: %v\n", p.URL, err)
+		return
+	}
+
+	p.etag = resp.Header().Get("ETag")
+}
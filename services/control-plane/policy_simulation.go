@@ -0,0 +1,131 @@
+package main
+
+// ConditionTrace records whether a single condition matched during
+// simulation, so operators can see exactly which part of a policy caused
+// (or didn't cause) a match. Indeterminate is set instead of Matched when
+// Condition.Field referenced an attribute the simulated context didn't
+// provide.
+type ConditionTrace struct {
+	Condition     PolicyCondition `json:"condition"`
+	Matched       bool            `json:"matched"`
+	Indeterminate bool            `json:"indeterminate,omitempty"`
+}
+
+// PolicyTrace describes how one policy was evaluated during a simulation,
+// independent of whether it was the policy that ultimately decided the
+// request.
+type PolicyTrace struct {
+	PolicyID             string           `json:"policy_id"`
+	Priority             int              `json:"priority"`
+	Action               PolicyAction     `json:"action"`
+	Conditions           []ConditionTrace `json:"conditions"`
+	ExpressionMatched    *bool            `json:"expression_matched,omitempty"`
+	ConditionTreeMatched *bool            `json:"condition_tree_matched,omitempty"`
+	Matched              bool             `json:"matched"`
+	Indeterminate        bool             `json:"indeterminate,omitempty"`
+}
+
+// SimulationResult is the full decision trace produced by
+// PolicyEngine.Simulate: every applicable policy, in the order they were
+// considered, plus the decision that would result.
+type SimulationResult struct {
+	Decision       PolicyResult  `json:"decision"`
+	PoliciesTraced []PolicyTrace `json:"policies_traced"`
+}
+
+// Simulate evaluates every enabled policy applicable to ctx.Resource, in
+// priority order, and returns a full trace of every policy considered
+// alongside the same decision Evaluate would reach (reduceDecision, under
+// ctx.Resource's CombiningAlgorithm) — unlike Evaluate, which only returns
+// the decision. This is the basis for the policy dry-run / simulate
+// endpoint, letting operators see near-misses instead of only the winning
+// policy.
+func (pe *PolicyEngine) Simulate(ctx PolicyContext) SimulationResult {
+	pe.mu.RLock()
+	defer pe.mu.RUnlock()
+
+	algo := pe.combiningAlgorithmLocked(ctx.Resource)
+
+	// pe.order is already sorted by priority (higher first); see Evaluate.
+	var applicablePolicies []*Policy
+	for _, policy := range pe.order {
+		if policy.Enabled && policy.Resource == ctx.Resource {
+			applicablePolicies = append(applicablePolicies, policy)
+		}
+	}
+
+	result := SimulationResult{}
+
+	var denies, permits []*Policy
+	var obligations []Obligation
+	indeterminate := false
+
+	for _, policy := range applicablePolicies {
+		trace := PolicyTrace{
+			PolicyID:   policy.ID,
+			Priority:   policy.Priority,
+			Action:     policy.Action,
+			Conditions: make([]ConditionTrace, 0, len(policy.Conditions)),
+		}
+
+		conditionsMatched := true
+		conditionsIndeterminate := false
+		for _, condition := range policy.Conditions {
+			matched, ind := pe.evaluateConditionTri(condition, ctx)
+			trace.Conditions = append(trace.Conditions, ConditionTrace{Condition: condition, Matched: matched, Indeterminate: ind})
+			if ind {
+				conditionsIndeterminate = true
+				continue
+			}
+			if !matched {
+				conditionsMatched = false
+			}
+		}
+
+		expressionMatched := true
+		if policy.Expression != "" {
+			expressionMatched = pe.evaluatePolicyExpression(policy, ctx)
+			trace.ExpressionMatched = &expressionMatched
+		}
+
+		conditionTreeMatched := true
+		if policy.ConditionTree != nil {
+			conditionTreeMatched = pe.evaluateConditionTree(policy, ctx)
+			trace.ConditionTreeMatched = &conditionTreeMatched
+		}
+
+		trace.Indeterminate = conditionsIndeterminate
+		trace.Matched = !conditionsIndeterminate && conditionsMatched && expressionMatched && conditionTreeMatched
+		result.PoliciesTraced = append(result.PoliciesTraced, trace)
+
+		if conditionsIndeterminate {
+			indeterminate = true
+			continue
+		}
+		if !trace.Matched {
+			continue
+		}
+		if algo == CombineFirstApplicable && len(denies)+len(permits) > 0 {
+			continue
+		}
+
+		for _, obligation := range policy.Obligations {
+			obligation.PolicyID = policy.ID
+			obligations = append(obligations, obligation)
+		}
+
+		switch policy.Action {
+		case ActionDeny:
+			denies = append(denies, policy)
+		default:
+			permits = append(permits, policy)
+		}
+	}
+
+	// Unlike Evaluate, Simulate always walks every applicable policy so the
+	// trace is complete; under CombineFirstApplicable only the first matched
+	// policy feeds denies/permits and obligations, so the decision is the
+	// one Evaluate reaches after stopping at that policy.
+	result.Decision = reduceDecision(algo, denies, permits, obligations, indeterminate)
+	return result
+}
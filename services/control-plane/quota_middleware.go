@@ -0,0 +1,144 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/allsource/control-plane/internal/quotas"
+	"github.com/gin-gonic/gin"
+)
+
+// QuotaMiddleware enforces per-tenant rate limits via limiter, classifying
+// each request into a quotas.RouteGroup by quotas.ClassifyRoute. It must
+// run after AuthMiddleware, since it reads GetAuthContext(c).TenantID, and
+// rejects with 429 plus Retry-After/X-RateLimit-Remaining headers instead
+// of letting an over-quota request reach a handler and add load to core.
+func QuotaMiddleware(limiter *quotas.Limiter, auditLogger *AuditLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth, err := GetAuthContext(c)
+		if err != nil {
+			// No auth context means AuthMiddleware let this request through
+			// unauthenticated (health/metrics/notify); nothing to meter.
+			c.Next()
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+		group := quotas.ClassifyRoute(c.Request.Method, route)
+
+		result := limiter.Allow(auth.TenantID, group, time.Now())
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+
+		if !result.Allowed {
+			retryAfterSeconds := int(result.RetryAfter.Seconds()) + 1
+			c.Header("Retry-After", strconv.Itoa(retryAfterSeconds))
+			auditLogger.LogOperationEvent("quota_rejected", string(group), auth.UserID, "rejected")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":     "rate limit exceeded",
+				"group":     group,
+				"tenant_id": auth.TenantID,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// OperationsConcurrencyMiddleware bounds how many /api/v1/operations
+// requests a single tenant can have in flight at once. A token bucket
+// alone doesn't stop a handful of slow, concurrent operations from
+// overwhelming core while staying under the rate limit, so this tracks
+// in-flight requests separately and is applied only to the operations
+// group.
+func OperationsConcurrencyMiddleware(limiter *quotas.Limiter, auditLogger *AuditLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth, err := GetAuthContext(c)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		release, ok := limiter.AcquireOperationSlot(auth.TenantID)
+		if !ok {
+			auditLogger.LogOperationEvent("quota_rejected", "operations_concurrency", auth.UserID, "rejected")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": "too many in-flight operations for this tenant",
+			})
+			return
+		}
+		defer release()
+
+		c.Next()
+	}
+}
+
+// tenantQuotasBody is the JSON shape of the tenant quotas endpoints,
+// mirroring quotas.TenantQuotas with omitted groups left on the Limiter's
+// defaults.
+type tenantQuotasBody struct {
+	Read                  *quotas.Limit `json:"read,omitempty"`
+	Write                 *quotas.Limit `json:"write,omitempty"`
+	Operations            *quotas.Limit `json:"operations,omitempty"`
+	OperationsConcurrency int           `json:"operations_concurrency,omitempty"`
+}
+
+func tenantQuotasToBody(q quotas.TenantQuotas) tenantQuotasBody {
+	body := tenantQuotasBody{OperationsConcurrency: q.OperationsConcurrency}
+	if limit, ok := q.Limits[quotas.GroupRead]; ok {
+		body.Read = &limit
+	}
+	if limit, ok := q.Limits[quotas.GroupWrite]; ok {
+		body.Write = &limit
+	}
+	if limit, ok := q.Limits[quotas.GroupOperations]; ok {
+		body.Operations = &limit
+	}
+	return body
+}
+
+func (body tenantQuotasBody) toTenantQuotas() quotas.TenantQuotas {
+	q := quotas.TenantQuotas{Limits: make(map[quotas.RouteGroup]quotas.Limit), OperationsConcurrency: body.OperationsConcurrency}
+	if body.Read != nil {
+		q.Limits[quotas.GroupRead] = *body.Read
+	}
+	if body.Write != nil {
+		q.Limits[quotas.GroupWrite] = *body.Write
+	}
+	if body.Operations != nil {
+		q.Limits[quotas.GroupOperations] = *body.Operations
+	}
+	return q
+}
+
+// getTenantQuotasHandler returns tenantID's quota overrides, or defaults
+// (empty groups) if it has none.
+func (cp *ControlPlaneV1) getTenantQuotasHandler(c *gin.Context) {
+	tenantID := c.Param("id")
+	q, _ := cp.quotaLimiter.TenantQuotas(tenantID)
+	c.JSON(http.StatusOK, tenantQuotasToBody(q))
+}
+
+// updateTenantQuotasHandler replaces tenantID's quota overrides. Any group
+// omitted from the request body falls back to the Limiter's default for
+// that group, same as a tenant with no overrides at all.
+func (cp *ControlPlaneV1) updateTenantQuotasHandler(c *gin.Context) {
+	tenantID := c.Param("id")
+	auth, _ := GetAuthContext(c)
+
+	var body tenantQuotasBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	q := body.toTenantQuotas()
+	cp.quotaLimiter.SetTenantQuotas(tenantID, q)
+	cp.auditLogger.LogTenantEvent("update_quotas", tenantID, auth.UserID, "tenant quotas updated")
+
+	c.JSON(http.StatusOK, tenantQuotasToBody(q))
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+
+	"github.com/allsource/control-plane/internal/domain/entities"
+	"github.com/allsource/control-plane/internal/domain/repositories"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RecoveryMiddleware recovers from panics in downstream handlers, logs the
+// stack trace, emits a "system.panic" audit event, bumps PanicsTotal, and
+// returns a structured 500 response carrying a correlation ID. It is
+// registered ahead of PrometheusMiddleware, whose recording runs after
+// c.Next() returns and is not deferred, so a request that panics is counted
+// in PanicsTotal and not in the HTTP request metrics.
+func RecoveryMiddleware(metrics *ControlPlaneMetrics, auditRepo repositories.AuditRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			correlationID := uuid.NewString()
+			route := c.FullPath()
+			if route == "" {
+				route = c.Request.URL.Path
+			}
+			method := c.Request.Method
+
+			fmt.Fprintf(os.Stderr, "panic recovered [%s] %s %s: %v\n%s\n",
+				correlationID, method, route, rec, debug.Stack())
+
+			metrics.PanicsTotal.WithLabelValues(route, method).Inc()
+
+			var userID, tenantID string
+			if auth, exists := c.Get("auth"); exists {
+				if authCtx, ok := auth.(*AuthContext); ok {
+					userID = authCtx.UserID
+					tenantID = authCtx.TenantID
+				}
+			}
+
+			auditEvent, err := entities.NewAuditEvent("system.panic", "panic", method, route)
+			if err == nil {
+				auditEvent.WithUser(userID, "").
+					WithTenant(tenantID).
+					WithStatus(http.StatusInternalServerError, 0).
+					WithError(fmt.Sprintf("%v", rec)).
+					AddMetadata("correlation_id", correlationID).
+					AddMetadata("stack", string(debug.Stack()))
+				_ = auditRepo.Log(auditEvent)
+			}
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error":          "internal server error",
+				"correlation_id": correlationID,
+			})
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RecoveryMiddlewareV1 recovers from panics in downstream handlers, modeled
+// on RecoveryMiddleware but logging through ControlPlaneV1's file-based
+// AuditLogger rather than the modern repositories.AuditRepository. It logs
+// the stack trace, emits a "system.panic" audit event, bumps
+// PanicsRecoveredTotal, and returns a structured 500 response carrying a
+// correlation ID instead of Gin's default HTML panic page.
+func RecoveryMiddlewareV1(metrics *ControlPlaneMetrics, auditLogger *AuditLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			correlationID := uuid.NewString()
+			route := c.FullPath()
+			if route == "" {
+				route = c.Request.URL.Path
+			}
+			method := c.Request.Method
+
+			fmt.Fprintf(os.Stderr, "panic recovered [%s] %s %s: %v\n%s\n",
+				correlationID, method, route, rec, debug.Stack())
+
+			metrics.PanicsRecoveredTotal.Inc()
+
+			var userID string
+			if auth, exists := c.Get("auth"); exists {
+				if authCtx, ok := auth.(*AuthContext); ok {
+					userID = authCtx.UserID
+				}
+			}
+
+			auditLogger.Log(AuditEvent{
+				EventType:     "system.panic",
+				UserID:        userID,
+				Action:        "panic",
+				Method:        method,
+				Path:          c.Request.URL.Path,
+				RouteTemplate: route,
+				StatusCode:    http.StatusInternalServerError,
+				Error:         fmt.Sprintf("%v", rec),
+				Metadata: map[string]interface{}{
+					"correlation_id": correlationID,
+				},
+			})
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error":          "internal server error",
+				"correlation_id": correlationID,
+			})
+		}()
+
+		c.Next()
+	}
+}
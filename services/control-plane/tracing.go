@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"time"
 
+	"github.com/allsource/control-plane/internal/cluster"
 	"github.com/gin-gonic/gin"
+	"github.com/go-resty/resty/v2"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
@@ -77,13 +82,16 @@ func InitTracing(config TracingConfig) (func(context.Context) error, error) {
 	return tp.Shutdown, nil
 }
 
-// TracingMiddleware adds OpenTelemetry tracing to Gin requests
+// TracingMiddleware adds OpenTelemetry tracing to Gin requests. It is
+// registered after AuthMiddleware in setupMiddleware, so by the time it
+// runs on an authenticated route the "auth" context AuthMiddleware set is
+// already available to tag the span with.
 func TracingMiddleware(tracerName string) gin.HandlerFunc {
 	tracer := otel.Tracer(tracerName)
 
 	return func(c *gin.Context) {
-		// Skip health and metrics endpoints
-		if c.Request.URL.Path == "/health" || c.Request.URL.Path == "/metrics" {
+		// Skip health, metrics, and the peer gossip receiver
+		if c.Request.URL.Path == "/health" || c.Request.URL.Path == "/metrics" || c.Request.URL.Path == cluster.NotifyPath {
 			c.Next()
 			return
 		}
@@ -94,13 +102,18 @@ func TracingMiddleware(tracerName string) gin.HandlerFunc {
 			&ginCarrier{c: c},
 		)
 
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+
 		// Start span
-		spanName := fmt.Sprintf("%s %s", c.Request.Method, c.Request.URL.Path)
+		spanName := fmt.Sprintf("%s %s", c.Request.Method, route)
 		ctx, span := tracer.Start(ctx, spanName,
 			trace.WithSpanKind(trace.SpanKindServer),
 			trace.WithAttributes(
-				semconv.HTTPMethodKey.String(c.Request.Method),
-				semconv.HTTPRouteKey.String(c.FullPath()),
+				attribute.String("http.method", c.Request.Method),
+				attribute.String("http.route", route),
 				semconv.HTTPURLKey.String(c.Request.URL.String()),
 				semconv.HTTPUserAgentKey.String(c.Request.UserAgent()),
 				semconv.HTTPClientIPKey.String(c.ClientIP()),
@@ -118,10 +131,8 @@ func TracingMiddleware(tracerName string) gin.HandlerFunc {
 			if auth, ok := authCtx.(*AuthContext); ok {
 				span.SetAttributes(
 					attribute.String("user.id", auth.UserID),
-					attribute.String("user.name", auth.Username),
 					attribute.String("tenant.id", auth.TenantID),
-					attribute.String("user.role", string(auth.Role)),
-					attribute.Bool("is_api_key", auth.IsAPIKey),
+					attribute.String("auth.role", string(auth.Role)),
 				)
 			}
 		}
@@ -141,12 +152,15 @@ func TracingMiddleware(tracerName string) gin.HandlerFunc {
 			attribute.Float64("http.duration_ms", float64(duration.Milliseconds())),
 		)
 
-		// Mark span as error if status code >= 400
+		// Reflect the response status on the span itself, not just as an attribute
 		if statusCode >= 400 {
-			span.SetAttributes(attribute.Bool("error", true))
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
 			if len(c.Errors) > 0 {
+				span.RecordError(c.Errors.Last())
 				span.SetAttributes(attribute.String("error.message", c.Errors.String()))
 			}
+		} else {
+			span.SetStatus(codes.Ok, "")
 		}
 
 		// Add custom events for notable actions
@@ -158,6 +172,64 @@ func TracingMiddleware(tracerName string) gin.HandlerFunc {
 	}
 }
 
+// countingSpanExporter wraps a sdktrace.SpanExporter, incrementing
+// metrics.TraceExportErrorsTotal whenever a batch fails to export so a
+// misconfigured or unreachable OTLP collector is visible as a metric
+// rather than only a log line.
+type countingSpanExporter struct {
+	sdktrace.SpanExporter
+	metrics *ControlPlaneMetrics
+}
+
+func (e *countingSpanExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	err := e.SpanExporter.ExportSpans(ctx, spans)
+	if err != nil && e.metrics != nil {
+		e.metrics.TraceExportErrorsTotal.Inc()
+	}
+	return err
+}
+
+// InitOTLPTracing initializes OpenTelemetry with an OTLP/HTTP exporter
+// pointed at endpoint. An empty endpoint (OTEL_EXPORTER_OTLP_ENDPOINT
+// unset) disables tracing and returns a no-op shutdown func, so
+// ControlPlaneV1 runs the same with or without a collector configured.
+func InitOTLPTracing(endpoint string, metrics *ControlPlaneMetrics) (func(context.Context) error, error) {
+	if endpoint == "" {
+		log.Println("📊 OTLP tracing disabled (OTEL_EXPORTER_OTLP_ENDPOINT not set)")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exp, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpoint(endpoint),
+		otlptracehttp.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(serviceVersion),
+			attribute.String("environment", getEnvironment()),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(&countingSpanExporter{SpanExporter: exp, metrics: metrics}),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	log.Printf("📊 OTLP tracing enabled (endpoint: %s)\n", endpoint)
+	return tp.Shutdown, nil
+}
+
 // ginCarrier is a carrier for extracting trace context from Gin context
 type ginCarrier struct {
 	c *gin.Context